@@ -0,0 +1,5 @@
+data "freeipa_preserved_users" "all" {}
+
+output "preserved_logins" {
+  value = [for user in data.freeipa_preserved_users.all.users : user.uid]
+}
@@ -0,0 +1,5 @@
+data "freeipa_servers" "all" {}
+
+output "ca_servers" {
+  value = [for server in data.freeipa_servers.all.servers : server.cn if contains(server.roles, "CA server")]
+}
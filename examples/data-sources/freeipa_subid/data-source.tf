@@ -0,0 +1,9 @@
+# Look up the subordinate IDs of a user
+data "freeipa_subid" "john_doe" {
+  owner = "john.doe"
+}
+
+# Find who owns a subordinate user ID
+data "freeipa_subid" "owner" {
+  subuidnumber = 2147483648
+}
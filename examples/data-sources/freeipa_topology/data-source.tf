@@ -0,0 +1,6 @@
+# Missing replication links are reported as warnings during plan
+data "freeipa_topology" "current" {}
+
+output "topology_in_order" {
+  value = alltrue([for suffix in data.freeipa_topology.current.suffixes : suffix.in_order])
+}
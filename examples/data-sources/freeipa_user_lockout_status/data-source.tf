@@ -0,0 +1,7 @@
+data "freeipa_user_lockout_status" "john_doe" {
+  uid = "john.doe"
+}
+
+output "john_doe_locked_on" {
+  value = [for status in data.freeipa_user_lockout_status.john_doe.lockout_status : status.server if status.locked]
+}
@@ -0,0 +1,8 @@
+resource "freeipa_certmap_rule" "smartcards" {
+  cn          = "smartcards"
+  description = "Smart cards issued by the company CA"
+  maprule     = "(ipacertmapdata=X509:<I>{issuer_dn!nss_x500}<S>{subject_dn!nss_x500})"
+  matchrule   = "<ISSUER>CN=Smart Card CA,O=EXAMPLE.COM"
+  domains     = ["example.com"]
+  priority    = 10
+}
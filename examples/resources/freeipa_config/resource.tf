@@ -0,0 +1,18 @@
+resource "freeipa_config" "global" {
+  defaultloginshell  = "/bin/bash"
+  homesrootdir       = "/home"
+  maxusernamelength  = 64
+  defaultemaildomain = "example.com"
+
+  configstring = [
+    "KDC:Disable Last Success",
+  ]
+
+  userauthtype = [
+    "password",
+    "otp",
+  ]
+
+  # Put the attributes above back to their previous values when destroyed
+  restore_on_destroy = true
+}
@@ -0,0 +1,6 @@
+resource "freeipa_dns_server" "ipa1" {
+  server        = "ipa1.paris.example.com"
+  forwarders    = ["192.0.2.53", "198.51.100.53 port 5353"]
+  forwardpolicy = "only"
+  soamname      = "ns1.example.com."
+}
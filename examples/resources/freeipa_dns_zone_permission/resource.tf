@@ -0,0 +1,3 @@
+resource "freeipa_dns_zone_permission" "team" {
+  zone = "team.example.com."
+}
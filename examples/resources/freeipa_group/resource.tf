@@ -1,4 +1,30 @@
 resource "freeipa_group" "important_users" {
   cn          = "important_users"
   description = "A group of important users"
+  gidnumber   = 10042
+}
+
+# Used for access control only, without a GID
+resource "freeipa_group" "admins_acl" {
+  cn          = "admins_acl"
+  description = "Administrators (access control only)"
+  type        = "nonposix"
+}
+
+# Holds members from a trusted Active Directory domain
+resource "freeipa_group" "ad_admins" {
+  cn          = "ad_admins"
+  description = "Active Directory administrators"
+  type        = "external"
+}
+
+resource "freeipa_group" "engineering" {
+  cn          = "engineering"
+  description = "Engineering department"
+
+  # Attribute not modeled by the resource, sent with setattr/addattr/delattr
+  extra_attributes {
+    name   = "businesscategory"
+    values = ["engineering", "research"]
+  }
 }
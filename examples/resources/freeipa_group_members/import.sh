@@ -0,0 +1,2 @@
+# The members of a group can be imported using the group name
+terraform import freeipa_group_members.important_users important_users
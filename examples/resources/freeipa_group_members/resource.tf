@@ -0,0 +1,17 @@
+# Any member not listed here is removed from the group
+resource "freeipa_group_members" "important_users" {
+  group = freeipa_group.important_users.cn
+
+  users = [
+    freeipa_user.john_doe.uid,
+    "jane.doe",
+  ]
+
+  groups = [
+    freeipa_group.engineering.cn,
+  ]
+
+  services = [
+    "HTTP/web.example.com@EXAMPLE.COM",
+  ]
+}
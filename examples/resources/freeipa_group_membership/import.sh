@@ -0,0 +1,6 @@
+# Group memberships can be imported using the group name, the member type and
+# the member identifier, with a /manager suffix for member managers
+terraform import freeipa_group_membership.managers ipausers/user/root/manager
+
+# Service members include the service and host names
+terraform import freeipa_group_membership.web web_services/service/HTTP/web.example.com
@@ -0,0 +1,4 @@
+resource "freeipa_location" "paris" {
+  idnsname    = "paris"
+  description = "Paris datacenter"
+}
@@ -0,0 +1,3 @@
+resource "freeipa_passkey_config" "realm" {
+  require_user_verification = true
+}
@@ -0,0 +1,10 @@
+resource "freeipa_realm_domains" "realm" {
+  domains = [
+    "example.com", # The IPA domain must always be part of the list
+    "example.org",
+    "example.net",
+  ]
+
+  # Allow domains that do not have the matching DNS records yet
+  force = true
+}
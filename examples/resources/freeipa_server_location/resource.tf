@@ -0,0 +1,5 @@
+resource "freeipa_server_location" "ipa1" {
+  server        = "ipa1.paris.example.com"
+  location      = freeipa_location.paris.idnsname
+  serviceweight = 200
+}
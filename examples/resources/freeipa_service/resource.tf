@@ -1,3 +1,9 @@
 resource "freeipa_service" "ftp" {
   krbcanonicalname = "ftp/auth.pie.prologin.org"
+
+  # Allow the members of the admins group to retrieve the keytab
+  read_keys_group = ["admins"]
+
+  # Allow the host itself to create the keytab
+  write_keys_host = ["auth.pie.prologin.org"]
 }
@@ -0,0 +1,11 @@
+resource "freeipa_service_delegation_rule" "frontends" {
+  cn = "frontends"
+
+  members = [
+    "HTTP/www.example.com@EXAMPLE.COM",
+  ]
+
+  targets = [
+    freeipa_service_delegation_target.backends.cn,
+  ]
+}
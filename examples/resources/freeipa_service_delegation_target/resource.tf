@@ -0,0 +1,8 @@
+resource "freeipa_service_delegation_target" "backends" {
+  cn = "backends"
+
+  members = [
+    "HTTP/api.example.com@EXAMPLE.COM",
+    "cifs/files.example.com@EXAMPLE.COM",
+  ]
+}
@@ -0,0 +1,25 @@
+resource "freeipa_stage_user" "jane_doe" {
+  uid       = "jane.doe"
+  givenname = "Jane"
+  sn        = "Doe"
+
+  # Only sent when the stage user is created, so the password does not have to
+  # stay in the configuration and importing the user does not produce a diff
+  initial_password = "ThisPasswordIsDefinitelyExpiredAlready"
+
+  mail = [
+    "jane.doe@example.com",
+  ]
+
+  # Set to true on the first working day to turn the stage user into an active
+  # user. Once activated, the user should be managed by a freeipa_user resource:
+  #
+  #   import {
+  #     to = freeipa_user.jane_doe
+  #     id = "jane.doe"
+  #   }
+  #
+  # and this resource can then be removed from the configuration, which leaves
+  # the active user untouched.
+  activate = false
+}
@@ -0,0 +1,4 @@
+resource "freeipa_subid" "john_doe" {
+  owner       = "john.doe"
+  description = "Rootless containers for john.doe"
+}
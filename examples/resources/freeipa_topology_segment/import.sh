@@ -0,0 +1,2 @@
+# Topology segments can be imported using the suffix and the segment name
+terraform import freeipa_topology_segment.paris_to_london domain:ipa1.paris.example.com-to-ipa1.london.example.com
@@ -0,0 +1,6 @@
+resource "freeipa_topology_segment" "paris_to_london" {
+  suffix    = "domain"
+  cn        = "ipa1.paris.example.com-to-ipa1.london.example.com"
+  leftnode  = "ipa1.paris.example.com"
+  rightnode = "ipa1.london.example.com"
+}
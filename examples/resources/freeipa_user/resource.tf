@@ -13,4 +13,65 @@ resource "freeipa_user" "john_doe" {
     "john.doe@example.com",
     "john@example.com"
   ]
+
+  loginshell      = "/bin/zsh"
+  title           = "Site reliability engineer"
+  telephonenumber = ["+33 1 23 45 67 89"]
+  l               = "Paris"
+  ou              = "Infrastructure"
+  manager         = "jane.doe"
+
+  # Keep the former login working as a Kerberos principal alias
+  principal_aliases = ["jdoe@EXAMPLE.COM"]
+
+  # Change this value (e.g. to the helpdesk ticket number) to unlock the
+  # account after too many failed authentications, see the
+  # freeipa_user_lockout_status data source
+  unlock_trigger = "HELPDESK-1234"
+
+  sshpubkey = [
+    "ssh-ed25519 AAAAC3NzaC1lZDI1NTE5AAAAIGb1J9bZt6m3lNQ2Xr7Qk3rW8l9h5M0dYpVtTqfBQ7xE john.doe@laptop",
+  ]
+}
+
+resource "freeipa_user" "jane_doe" {
+  uid       = "jane.doe"
+  givenname = "Jane"
+  sn        = "Doe"
+
+  # The generated password is available (as a sensitive value) in
+  # freeipa_user.jane_doe.randompassword
+  random_password = true
+
+  # Keep the UID and group history when the user is removed, and restore the
+  # preserved user if the resource is created again
+  preserve_on_destroy = true
+  restore_preserved   = true
+
+  # Smart card certificate, subject and expiry are exposed in certificate_details
+  certificates = [
+    file("${path.module}/certificates/jane.doe.pem"),
+  ]
+
+  certmapdata = [
+    "X509:<I>O=EXAMPLE.COM,CN=Smart Card CA<S>O=EXAMPLE.COM,CN=Jane Doe",
+  ]
+
+  passkey = [
+    "passkey:N8Pc7mhtNq4vv1nnhqfVtA==,MFkwEwYHKoZIzj0CAQYIKoZIzj0DAQcDQgAEsxJUs4ZgFy5fvyuiMzEgu3V4jI4G3ZUpOfyqJdaIctuRy9jdn4/WzHwwAnYXl/5VHxIt+7nx6YQsIq3NILSlnQ==",
+  ]
+}
+
+resource "freeipa_user" "external_contractor" {
+  uid       = "ext.contractor"
+  givenname = "External"
+  sn        = "Contractor"
+
+  # Authenticate against an external identity provider
+  user_auth_type = ["idp"]
+  idp            = freeipa_idp.goauthentik-test-client.cn
+  idp_user_id    = "contractor@partner.example.org"
+
+  # End of the contract
+  krbprincipalexpiration = "2027-06-30T23:59:59Z"
 }
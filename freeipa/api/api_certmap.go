@@ -0,0 +1,53 @@
+package api
+
+import "encoding/json"
+
+type CertMapRule struct {
+	CN               []string      `json:"cn"`                  // Rule name
+	Description      []string      `json:"description"`         // Rule description
+	MapRule          []string      `json:"ipacertmapmaprule"`   // Rule used to map the certificate to a user
+	MatchRule        []string      `json:"ipacertmapmatchrule"` // Rule used to check if a certificate can be used
+	AssociatedDomain []string      `json:"associateddomain"`    // Domains where the user is searched
+	Priority         []json.Number `json:"ipacertmappriority"`  // Rule priority (lower number means higher priority)
+	Enabled          []IPABool     `json:"ipaenabledflag"`      // Rule is enabled
+}
+
+type CertMapConfig struct {
+	PromptUsername []IPABool `json:"ipacertmappromptusername"` // Prompt for the username on smart card login
+}
+
+func (c *APIClient) CertMapRuleAdd(cn string, options JSON) (*CertMapRule, error) {
+	return apiRequest[CertMapRule, string](c, "certmaprule_add", options, cn)
+}
+
+func (c *APIClient) CertMapRuleDel(cn string, options JSON) (*JSON, error) {
+	return apiRequest[JSON, []string](c, "certmaprule_del", options, cn)
+}
+
+func (c *APIClient) CertMapRuleMod(cn string, options JSON) (*CertMapRule, error) {
+	return apiRequest[CertMapRule, string](c, "certmaprule_mod", options, cn)
+}
+
+func (c *APIClient) CertMapRuleShow(cn string, options JSON) (*CertMapRule, error) {
+	return apiRequest[CertMapRule, string](c, "certmaprule_show", options, cn)
+}
+
+func (c *APIClient) CertMapRuleFind(criteria string, options JSON) (*[]CertMapRule, error) {
+	return apiRequest[[]CertMapRule, string](c, "certmaprule_find", options, criteria)
+}
+
+func (c *APIClient) CertMapRuleEnable(cn string) (*bool, error) {
+	return apiRequest[bool, string](c, "certmaprule_enable", nil, cn)
+}
+
+func (c *APIClient) CertMapRuleDisable(cn string) (*bool, error) {
+	return apiRequest[bool, string](c, "certmaprule_disable", nil, cn)
+}
+
+func (c *APIClient) CertMapConfigMod(options JSON) (*CertMapConfig, error) {
+	return apiRequest[CertMapConfig, string](c, "certmapconfig_mod", options)
+}
+
+func (c *APIClient) CertMapConfigShow(options JSON) (*CertMapConfig, error) {
+	return apiRequest[CertMapConfig, string](c, "certmapconfig_show", options)
+}
@@ -0,0 +1,23 @@
+package api
+
+import "encoding/json"
+
+type Config struct {
+	DefaultLoginShell   []string      `json:"ipadefaultloginshell"`   // Default shell
+	HomesRootDir        []string      `json:"ipahomesrootdir"`        // Home directory base
+	DefaultPrimaryGroup []string      `json:"ipadefaultprimarygroup"` // Default users group
+	MaxUsernameLength   []json.Number `json:"ipamaxusernamelength"`   // Maximum username length
+	SearchRecordsLimit  []json.Number `json:"ipasearchrecordslimit"`  // Search size limit
+	SearchTimeLimit     []json.Number `json:"ipasearchtimelimit"`     // Search time limit
+	DefaultEmailDomain  []string      `json:"ipadefaultemaildomain"`  // Default e-mail domain
+	ConfigString        []string      `json:"ipaconfigstring"`        // Password plugin features
+	UserAuthType        []string      `json:"ipauserauthtype"`        // Default user authentication types
+}
+
+func (c *APIClient) ConfigMod(options JSON) (*Config, error) {
+	return apiRequest[Config, string](c, "config_mod", options)
+}
+
+func (c *APIClient) ConfigShow(options JSON) (*Config, error) {
+	return apiRequest[Config, string](c, "config_show", options)
+}
@@ -0,0 +1,42 @@
+package api
+
+type DNSZone struct {
+	Name      []DNSName `json:"idnsname"`  // Zone name
+	ManagedBy []string  `json:"managedby"` // Permission allowed to manage the zone (DN)
+}
+
+type DNSServer struct {
+	ServerID      []string  `json:"idnsserverid"`      // Server name (FQDN)
+	Forwarders    []string  `json:"idnsforwarders"`    // Per-server forwarders
+	ForwardPolicy []string  `json:"idnsforwardpolicy"` // Per-server forward policy
+	SOAMName      []DNSName `json:"idnssoamname"`      // SOA mname override
+}
+
+func (c *APIClient) DNSZoneShow(idnsname string, options JSON) (*DNSZone, error) {
+	return apiRequest[DNSZone, string](c, "dnszone_show", options, idnsname)
+}
+
+func (c *APIClient) DNSZoneFind(criteria string, options JSON) (*[]DNSZone, error) {
+	return apiRequest[[]DNSZone, string](c, "dnszone_find", options, criteria)
+}
+
+// Adds a permission allowing to manage the zone
+func (c *APIClient) DNSZoneAddPermission(idnsname string) (*bool, error) {
+	return apiRequest[bool, string](c, "dnszone_add_permission", nil, idnsname)
+}
+
+func (c *APIClient) DNSZoneRemovePermission(idnsname string) (*bool, error) {
+	return apiRequest[bool, string](c, "dnszone_remove_permission", nil, idnsname)
+}
+
+func (c *APIClient) DNSServerMod(idnsserverid string, options JSON) (*DNSServer, error) {
+	return apiRequest[DNSServer, string](c, "dnsserver_mod", options, idnsserverid)
+}
+
+func (c *APIClient) DNSServerShow(idnsserverid string, options JSON) (*DNSServer, error) {
+	return apiRequest[DNSServer, string](c, "dnsserver_show", options, idnsserverid)
+}
+
+func (c *APIClient) DNSServerFind(criteria string, options JSON) (*[]DNSServer, error) {
+	return apiRequest[[]DNSServer, string](c, "dnsserver_find", options, criteria)
+}
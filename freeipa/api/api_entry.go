@@ -0,0 +1,10 @@
+package api
+
+// Any entry, with its attributes as returned by the API
+// Used to read attributes that are not modeled by the other types
+type Entry map[string]interface{}
+
+// Calls the given *_show method and returns the entry as is
+func (c *APIClient) EntryShow(method string, id string, options JSON) (*Entry, error) {
+	return apiRequest[Entry, string](c, method, options, id)
+}
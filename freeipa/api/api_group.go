@@ -1,10 +1,27 @@
 package api
 
-import "errors"
+import (
+	"encoding/json"
+	"errors"
+)
 
 type Group struct {
-	CN          []string `json:"cn"`          // Group name
-	Description []string `json:"description"` // Group description
+	CN          []string      `json:"cn"`          // Group name
+	Description []string      `json:"description"` // Group description
+	GIDNumber   []json.Number `json:"gidnumber"`   // Group ID number (POSIX groups)
+	ObjectClass []string      `json:"objectclass"` // Object classes, defining the group type
+
+	MemberUser           []string `json:"member_user"`           // Direct user members
+	MemberGroup          []string `json:"member_group"`          // Direct group members
+	MemberService        []string `json:"member_service"`        // Direct service members
+	MemberIDOverrideUser []string `json:"member_idoverrideuser"` // Direct user ID override members
+	ExternalMember       []string `json:"ipaexternalmember"`     // External members (SIDs)
+
+	MemberIndirectUser    []string `json:"memberindirect_user"`    // Indirect user members (through nested groups)
+	MemberIndirectGroup   []string `json:"memberindirect_group"`   // Indirect group members (through nested groups)
+	MemberIndirectService []string `json:"memberindirect_service"` // Indirect service members (through nested groups)
+	MemberManagerUser     []string `json:"membermanager_user"`     // User member managers
+	MemberManagerGroup    []string `json:"membermanager_group"`    // Group member managers
 }
 
 type GroupList struct {
@@ -0,0 +1,26 @@
+package api
+
+type Location struct {
+	Name        []DNSName `json:"idnsname"`    // Location name
+	Description []string  `json:"description"` // Location description
+}
+
+func (c *APIClient) LocationAdd(idnsname string, options JSON) (*Location, error) {
+	return apiRequest[Location, string](c, "location_add", options, idnsname)
+}
+
+func (c *APIClient) LocationDel(idnsname string, options JSON) (*JSON, error) {
+	return apiRequest[JSON, []string](c, "location_del", options, idnsname)
+}
+
+func (c *APIClient) LocationMod(idnsname string, options JSON) (*Location, error) {
+	return apiRequest[Location, string](c, "location_mod", options, idnsname)
+}
+
+func (c *APIClient) LocationShow(idnsname string, options JSON) (*Location, error) {
+	return apiRequest[Location, string](c, "location_show", options, idnsname)
+}
+
+func (c *APIClient) LocationFind(criteria string, options JSON) (*[]Location, error) {
+	return apiRequest[[]Location, string](c, "location_find", options, criteria)
+}
@@ -0,0 +1,13 @@
+package api
+
+type PasskeyConfig struct {
+	RequireUserVerification []IPABool `json:"iparequireuserverification"` // Require user verification during authentication
+}
+
+func (c *APIClient) PasskeyConfigMod(options JSON) (*PasskeyConfig, error) {
+	return apiRequest[PasskeyConfig, string](c, "passkeyconfig_mod", options)
+}
+
+func (c *APIClient) PasskeyConfigShow(options JSON) (*PasskeyConfig, error) {
+	return apiRequest[PasskeyConfig, string](c, "passkeyconfig_show", options)
+}
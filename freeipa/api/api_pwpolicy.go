@@ -0,0 +1,14 @@
+package api
+
+import "encoding/json"
+
+type PwPolicy struct {
+	CN                   []string      `json:"cn"`                         // Group the policy applies to
+	MaxFailure           []json.Number `json:"krbpwdmaxfailure"`           // Consecutive failures before lockout
+	FailureCountInterval []json.Number `json:"krbpwdfailurecountinterval"` // Period after which the failure count is reset (seconds)
+	LockoutDuration      []json.Number `json:"krbpwdlockoutduration"`      // Lockout duration (seconds)
+}
+
+func (c *APIClient) PwPolicyShow(options JSON) (*PwPolicy, error) {
+	return apiRequest[PwPolicy, string](c, "pwpolicy_show", options)
+}
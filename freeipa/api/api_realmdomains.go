@@ -0,0 +1,13 @@
+package api
+
+type RealmDomains struct {
+	AssociatedDomain []string `json:"associateddomain"` // Domains associated with the realm
+}
+
+func (c *APIClient) RealmDomainsMod(options JSON) (*RealmDomains, error) {
+	return apiRequest[RealmDomains, string](c, "realmdomains_mod", options)
+}
+
+func (c *APIClient) RealmDomainsShow(options JSON) (*RealmDomains, error) {
+	return apiRequest[RealmDomains, string](c, "realmdomains_show", options)
+}
@@ -0,0 +1,31 @@
+package api
+
+import "encoding/json"
+
+type Server struct {
+	CN            []string      `json:"cn"`                   // Server name (FQDN)
+	Location      []DNSName     `json:"ipalocation_location"` // Server location
+	ServiceWeight []json.Number `json:"ipaserviceweight"`     // Weight of the server in its location
+}
+
+type ServerRole struct {
+	Server string `json:"server_server"` // Server name (FQDN)
+	Role   string `json:"role_servrole"` // Role name
+	Status string `json:"status"`        // Role status
+}
+
+func (c *APIClient) ServerMod(cn string, options JSON) (*Server, error) {
+	return apiRequest[Server, string](c, "server_mod", options, cn)
+}
+
+func (c *APIClient) ServerShow(cn string, options JSON) (*Server, error) {
+	return apiRequest[Server, string](c, "server_show", options, cn)
+}
+
+func (c *APIClient) ServerFind(criteria string, options JSON) (*[]Server, error) {
+	return apiRequest[[]Server, string](c, "server_find", options, criteria)
+}
+
+func (c *APIClient) ServerRoleFind(options JSON) (*[]ServerRole, error) {
+	return apiRequest[[]ServerRole, string](c, "server_role_find", options)
+}
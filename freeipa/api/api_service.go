@@ -2,6 +2,15 @@ package api
 
 type Service struct {
 	KrbCanonicalName []string `json:"krbcanonicalname"` // Service name
+
+	ReadKeysUser       []string `json:"ipaallowedtoperform_read_keys_user"`       // Users allowed to retrieve the keytab
+	ReadKeysGroup      []string `json:"ipaallowedtoperform_read_keys_group"`      // Groups allowed to retrieve the keytab
+	ReadKeysHost       []string `json:"ipaallowedtoperform_read_keys_host"`       // Hosts allowed to retrieve the keytab
+	ReadKeysHostGroup  []string `json:"ipaallowedtoperform_read_keys_hostgroup"`  // Host groups allowed to retrieve the keytab
+	WriteKeysUser      []string `json:"ipaallowedtoperform_write_keys_user"`      // Users allowed to create the keytab
+	WriteKeysGroup     []string `json:"ipaallowedtoperform_write_keys_group"`     // Groups allowed to create the keytab
+	WriteKeysHost      []string `json:"ipaallowedtoperform_write_keys_host"`      // Hosts allowed to create the keytab
+	WriteKeysHostGroup []string `json:"ipaallowedtoperform_write_keys_hostgroup"` // Host groups allowed to create the keytab
 }
 
 func (c *APIClient) ServiceAdd(krbcanonicalname string, options JSON) (*Service, error) {
@@ -23,3 +32,21 @@ func (c *APIClient) ServiceShow(krbcanonicalname string, options JSON) (*Service
 func (c *APIClient) ServiceFind(criteria string, options JSON) (*[]Service, error) {
 	return apiRequest[[]Service, string](c, "service_find", options, criteria)
 }
+
+// Allows users, groups, hosts or host groups to retrieve the keytab (read_keys)
+func (c *APIClient) ServiceAllowRetrieveKeytab(krbcanonicalname string, options JSON) (*Service, error) {
+	return apiRequest[Service, string](c, "service_allow_retrieve_keytab", options, krbcanonicalname)
+}
+
+func (c *APIClient) ServiceDisallowRetrieveKeytab(krbcanonicalname string, options JSON) (*Service, error) {
+	return apiRequest[Service, string](c, "service_disallow_retrieve_keytab", options, krbcanonicalname)
+}
+
+// Allows users, groups, hosts or host groups to create the keytab (write_keys)
+func (c *APIClient) ServiceAllowCreateKeytab(krbcanonicalname string, options JSON) (*Service, error) {
+	return apiRequest[Service, string](c, "service_allow_create_keytab", options, krbcanonicalname)
+}
+
+func (c *APIClient) ServiceDisallowCreateKeytab(krbcanonicalname string, options JSON) (*Service, error) {
+	return apiRequest[Service, string](c, "service_disallow_create_keytab", options, krbcanonicalname)
+}
@@ -0,0 +1,68 @@
+package api
+
+type ServiceDelegationRule struct {
+	CN              []string `json:"cn"`                                       // Rule name
+	MemberPrincipal []string `json:"memberprincipal"`                          // Principals allowed to delegate
+	Targets         []string `json:"ipaallowedtarget_servicedelegationtarget"` // Targets of the delegation
+}
+
+type ServiceDelegationTarget struct {
+	CN              []string `json:"cn"`              // Target name
+	MemberPrincipal []string `json:"memberprincipal"` // Principals delegated to
+}
+
+func (c *APIClient) ServiceDelegationRuleAdd(cn string, options JSON) (*ServiceDelegationRule, error) {
+	return apiRequest[ServiceDelegationRule, string](c, "servicedelegationrule_add", options, cn)
+}
+
+func (c *APIClient) ServiceDelegationRuleDel(cn string, options JSON) (*JSON, error) {
+	return apiRequest[JSON, []string](c, "servicedelegationrule_del", options, cn)
+}
+
+func (c *APIClient) ServiceDelegationRuleShow(cn string, options JSON) (*ServiceDelegationRule, error) {
+	return apiRequest[ServiceDelegationRule, string](c, "servicedelegationrule_show", options, cn)
+}
+
+func (c *APIClient) ServiceDelegationRuleFind(criteria string, options JSON) (*[]ServiceDelegationRule, error) {
+	return apiRequest[[]ServiceDelegationRule, string](c, "servicedelegationrule_find", options, criteria)
+}
+
+func (c *APIClient) ServiceDelegationRuleAddMember(cn string, options JSON) (*JSON, error) {
+	return apiRequest[JSON, string](c, "servicedelegationrule_add_member", options, cn)
+}
+
+func (c *APIClient) ServiceDelegationRuleRemoveMember(cn string, options JSON) (*JSON, error) {
+	return apiRequest[JSON, string](c, "servicedelegationrule_remove_member", options, cn)
+}
+
+func (c *APIClient) ServiceDelegationRuleAddTarget(cn string, options JSON) (*JSON, error) {
+	return apiRequest[JSON, string](c, "servicedelegationrule_add_target", options, cn)
+}
+
+func (c *APIClient) ServiceDelegationRuleRemoveTarget(cn string, options JSON) (*JSON, error) {
+	return apiRequest[JSON, string](c, "servicedelegationrule_remove_target", options, cn)
+}
+
+func (c *APIClient) ServiceDelegationTargetAdd(cn string, options JSON) (*ServiceDelegationTarget, error) {
+	return apiRequest[ServiceDelegationTarget, string](c, "servicedelegationtarget_add", options, cn)
+}
+
+func (c *APIClient) ServiceDelegationTargetDel(cn string, options JSON) (*JSON, error) {
+	return apiRequest[JSON, []string](c, "servicedelegationtarget_del", options, cn)
+}
+
+func (c *APIClient) ServiceDelegationTargetShow(cn string, options JSON) (*ServiceDelegationTarget, error) {
+	return apiRequest[ServiceDelegationTarget, string](c, "servicedelegationtarget_show", options, cn)
+}
+
+func (c *APIClient) ServiceDelegationTargetFind(criteria string, options JSON) (*[]ServiceDelegationTarget, error) {
+	return apiRequest[[]ServiceDelegationTarget, string](c, "servicedelegationtarget_find", options, criteria)
+}
+
+func (c *APIClient) ServiceDelegationTargetAddMember(cn string, options JSON) (*JSON, error) {
+	return apiRequest[JSON, string](c, "servicedelegationtarget_add_member", options, cn)
+}
+
+func (c *APIClient) ServiceDelegationTargetRemoveMember(cn string, options JSON) (*JSON, error) {
+	return apiRequest[JSON, string](c, "servicedelegationtarget_remove_member", options, cn)
+}
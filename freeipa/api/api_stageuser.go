@@ -0,0 +1,32 @@
+package api
+
+func (c *APIClient) StageUserAdd(uid string, givenname string, sn string, options JSON) (*User, error) {
+	if options == nil {
+		options = JSON{}
+	}
+	options["givenname"] = givenname
+	options["sn"] = sn
+
+	return apiRequest[User, string](c, "stageuser_add", options, uid)
+}
+
+func (c *APIClient) StageUserDel(uid string, options JSON) (*JSON, error) {
+	return apiRequest[JSON, []string](c, "stageuser_del", options, uid)
+}
+
+func (c *APIClient) StageUserMod(uid string, options JSON) (*User, error) {
+	return apiRequest[User, string](c, "stageuser_mod", options, uid)
+}
+
+func (c *APIClient) StageUserShow(uid string, options JSON) (*User, error) {
+	return apiRequest[User, string](c, "stageuser_show", options, uid)
+}
+
+func (c *APIClient) StageUserFind(criteria string, options JSON) (*[]User, error) {
+	return apiRequest[[]User, string](c, "stageuser_find", options, criteria)
+}
+
+// Turns a stage user into an active user
+func (c *APIClient) StageUserActivate(uid string, options JSON) (*User, error) {
+	return apiRequest[User, string](c, "stageuser_activate", options, uid)
+}
@@ -0,0 +1,44 @@
+package api
+
+import "encoding/json"
+
+type SubID struct {
+	UniqueID     []string      `json:"ipauniqueid"`     // Unique ID
+	Owner        []string      `json:"ipaowner"`        // Owning user
+	Description  []string      `json:"description"`     // Subordinate ID description
+	SubUIDNumber []json.Number `json:"ipasubuidnumber"` // Start value of the subordinate user ID range
+	SubUIDCount  []json.Number `json:"ipasubuidcount"`  // Size of the subordinate user ID range
+	SubGIDNumber []json.Number `json:"ipasubgidnumber"` // Start value of the subordinate group ID range
+	SubGIDCount  []json.Number `json:"ipasubgidcount"`  // Size of the subordinate group ID range
+}
+
+// Generates and assigns subordinate IDs to a user
+func (c *APIClient) SubIDGenerate(owner string, options JSON) (*SubID, error) {
+	if options == nil {
+		options = JSON{}
+	}
+	options["ipaowner"] = owner
+
+	return apiRequest[SubID, string](c, "subid_generate", options)
+}
+
+func (c *APIClient) SubIDDel(ipauniqueid string, options JSON) (*JSON, error) {
+	return apiRequest[JSON, []string](c, "subid_del", options, ipauniqueid)
+}
+
+func (c *APIClient) SubIDMod(ipauniqueid string, options JSON) (*SubID, error) {
+	return apiRequest[SubID, string](c, "subid_mod", options, ipauniqueid)
+}
+
+func (c *APIClient) SubIDShow(ipauniqueid string, options JSON) (*SubID, error) {
+	return apiRequest[SubID, string](c, "subid_show", options, ipauniqueid)
+}
+
+func (c *APIClient) SubIDFind(criteria string, options JSON) (*[]SubID, error) {
+	return apiRequest[[]SubID, string](c, "subid_find", options, criteria)
+}
+
+// Finds the subordinate IDs matching a subordinate user ID
+func (c *APIClient) SubIDMatch(options JSON) (*[]SubID, error) {
+	return apiRequest[[]SubID, string](c, "subid_match", options)
+}
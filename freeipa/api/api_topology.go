@@ -0,0 +1,107 @@
+package api
+
+import (
+	"encoding/json"
+	"errors"
+)
+
+type TopologySuffix struct {
+	CN []string `json:"cn"` // Suffix name
+}
+
+type TopologySegment struct {
+	CN                           []string      `json:"cn"`                                // Segment name
+	LeftNode                     []string      `json:"iparepltoposegmentleftnode"`        // Left replication node
+	RightNode                    []string      `json:"iparepltoposegmentrightnode"`       // Right replication node
+	Direction                    []string      `json:"iparepltoposegmentdirection"`       // Direction of replication between the nodes
+	ReplicatedAttributeList      []string      `json:"nsds5replicatedattributelist"`      // Attributes excluded from incremental updates
+	ReplicatedAttributeListTotal []string      `json:"nsds5replicatedattributelisttotal"` // Attributes excluded from total updates
+	ReplicaStripAttrs            []string      `json:"nsds5replicastripattrs"`            // Attributes stripped from replicated updates
+	ReplicaTimeout               []json.Number `json:"nsds5replicatimeout"`               // Replication timeout (seconds)
+	ReplicaEnabled               []string      `json:"nsds5replicaenabled"`               // Replication agreement enabled ("on" or "off")
+}
+
+type TopologyVerification struct {
+	InOrder        bool                    `json:"in_order"`         // The topology is correct
+	ConnectErrors  []TopologyConnectError  `json:"connect_errors"`   // Servers unable to reach others
+	MaxAgmtsErrors []TopologyMaxAgmtsError `json:"max_agmts_errors"` // Servers with too many agreements
+	MaxAgmts       int                     `json:"max_agmts"`        // Recommended maximum number of agreements per server
+}
+
+// Returned by the API as (server, reachable servers, unreachable servers)
+type TopologyConnectError struct {
+	Server      string
+	Reachable   []string
+	Unreachable []string
+}
+
+func (e *TopologyConnectError) UnmarshalJSON(b []byte) (err error) {
+	var tuple []json.RawMessage
+	err = json.Unmarshal(b, &tuple)
+	if err != nil {
+		return err
+	}
+	if len(tuple) != 3 {
+		return errors.New("invalid topology connection error")
+	}
+
+	if err = json.Unmarshal(tuple[0], &e.Server); err != nil {
+		return err
+	}
+	if err = json.Unmarshal(tuple[1], &e.Reachable); err != nil {
+		return err
+	}
+
+	return json.Unmarshal(tuple[2], &e.Unreachable)
+}
+
+// Returned by the API as (server, replication partners)
+type TopologyMaxAgmtsError struct {
+	Server   string
+	Partners []string
+}
+
+func (e *TopologyMaxAgmtsError) UnmarshalJSON(b []byte) (err error) {
+	var tuple []json.RawMessage
+	err = json.Unmarshal(b, &tuple)
+	if err != nil {
+		return err
+	}
+	if len(tuple) != 2 {
+		return errors.New("invalid topology agreements error")
+	}
+
+	if err = json.Unmarshal(tuple[0], &e.Server); err != nil {
+		return err
+	}
+
+	return json.Unmarshal(tuple[1], &e.Partners)
+}
+
+func (c *APIClient) TopologySuffixFind(criteria string, options JSON) (*[]TopologySuffix, error) {
+	return apiRequest[[]TopologySuffix, string](c, "topologysuffix_find", options, criteria)
+}
+
+func (c *APIClient) TopologySuffixVerify(cn string, options JSON) (*TopologyVerification, error) {
+	return apiRequest[TopologyVerification, string](c, "topologysuffix_verify", options, cn)
+}
+
+func (c *APIClient) TopologySegmentAdd(suffix string, cn string, options JSON) (*TopologySegment, error) {
+	return apiRequest[TopologySegment, string](c, "topologysegment_add", options, suffix, cn)
+}
+
+func (c *APIClient) TopologySegmentDel(suffix string, cn string, options JSON) (*JSON, error) {
+	return apiRequest[JSON, []string](c, "topologysegment_del", options, suffix, cn)
+}
+
+func (c *APIClient) TopologySegmentMod(suffix string, cn string, options JSON) (*TopologySegment, error) {
+	return apiRequest[TopologySegment, string](c, "topologysegment_mod", options, suffix, cn)
+}
+
+func (c *APIClient) TopologySegmentShow(suffix string, cn string, options JSON) (*TopologySegment, error) {
+	return apiRequest[TopologySegment, string](c, "topologysegment_show", options, suffix, cn)
+}
+
+func (c *APIClient) TopologySegmentFind(suffix string, options JSON) (*[]TopologySegment, error) {
+	return apiRequest[[]TopologySegment, string](c, "topologysegment_find", options, suffix)
+}
@@ -1,5 +1,7 @@
 package api
 
+import "encoding/json"
+
 type User struct {
 	UID                   []string `json:"uid"`       // Login
 	GivenName             []string `json:"givenname"` // First name
@@ -7,8 +9,57 @@ type User struct {
 	KrbPasswordExpiration []struct {
 		DateTime IPATime `json:"__datetime__"`
 	} `json:"krbpasswordexpiration"` // Password expiration
-	Mail          []string `json:"mail"`          // Email
-	HomeDirectory []string `json:"homedirectory"` // Home directory
+	KrbPrincipalExpiration []struct {
+		DateTime IPATime `json:"__datetime__"`
+	} `json:"krbprincipalexpiration"` // Principal expiration
+	UserCertificate []struct {
+		Value string `json:"__base64__"`
+	} `json:"usercertificate"` // Certificates (base64 encoded DER)
+	KrbPrincipalName         []string      `json:"krbprincipalname"`         // Kerberos principals (including aliases)
+	KrbCanonicalName         []string      `json:"krbcanonicalname"`         // Canonical Kerberos principal
+	Mail                     []string      `json:"mail"`                     // Email
+	HomeDirectory            []string      `json:"homedirectory"`            // Home directory
+	LoginShell               []string      `json:"loginshell"`               // Login shell
+	UIDNumber                []json.Number `json:"uidnumber"`                // User ID number
+	GIDNumber                []json.Number `json:"gidnumber"`                // Group ID number
+	Gecos                    []string      `json:"gecos"`                    // GECOS
+	DisplayName              []string      `json:"displayname"`              // Display name
+	Initials                 []string      `json:"initials"`                 // Initials
+	CN                       []string      `json:"cn"`                       // Full name
+	Title                    []string      `json:"title"`                    // Job title
+	TelephoneNumber          []string      `json:"telephonenumber"`          // Telephone numbers
+	Mobile                   []string      `json:"mobile"`                   // Mobile telephone numbers
+	Pager                    []string      `json:"pager"`                    // Pager numbers
+	FacsimileTelephoneNumber []string      `json:"facsimiletelephonenumber"` // Fax numbers
+	Street                   []string      `json:"street"`                   // Street address
+	L                        []string      `json:"l"`                        // City
+	ST                       []string      `json:"st"`                       // State or province
+	PostalCode               []string      `json:"postalcode"`               // ZIP code
+	OU                       []string      `json:"ou"`                       // Organizational unit
+	EmployeeNumber           []string      `json:"employeenumber"`           // Employee number
+	EmployeeType             []string      `json:"employeetype"`             // Employee type
+	PreferredLanguage        []string      `json:"preferredlanguage"`        // Preferred language
+	DepartmentNumber         []string      `json:"departmentnumber"`         // Department numbers
+	CarLicense               []string      `json:"carlicense"`               // Car licenses
+	Manager                  []string      `json:"manager"`                  // Manager (login)
+	SSHPubKey                []string      `json:"ipasshpubkey"`             // SSH public keys
+	SSHPubKeyFP              []string      `json:"sshpubkeyfp"`              // SSH public key fingerprints
+	UserAuthType             []string      `json:"ipauserauthtype"`          // Supported authentication types
+	IdPConfigLink            []string      `json:"ipaidpconfiglink"`         // External identity provider
+	IdPSub                   []string      `json:"ipaidpsub"`                // User identifier in the external identity provider
+	Passkey                  []string      `json:"ipapasskey"`               // Passkey mappings
+	CertMapData              []string      `json:"ipacertmapdata"`           // Certificate mapping data
+	NSAccountLock            IPABool       `json:"nsaccountlock"`            // Account disabled
+	Preserved                IPABool       `json:"preserved"`                // Deleted but preserved
+	RandomPassword           string        `json:"randompassword"`           // Generated random password
+}
+
+// Lockout status of a user on a given server
+type UserStatus struct {
+	Server                string            `json:"server"`                // Server name (FQDN)
+	KrbLoginFailedCount   IPAValues         `json:"krbloginfailedcount"`   // Failed authentications count
+	KrbLastSuccessfulAuth []IPAOptionalTime `json:"krblastsuccessfulauth"` // Last successful authentication
+	KrbLastFailedAuth     []IPAOptionalTime `json:"krblastfailedauth"`     // Last failed authentication
 }
 
 func (c *APIClient) UserAdd(uid string, givenname string, sn string, options JSON) (*User, error) {
@@ -25,6 +76,11 @@ func (c *APIClient) UserDel(uid string, options JSON) (*JSON, error) {
 	return apiRequest[JSON, []string](c, "user_del", options, uid)
 }
 
+// Restores a preserved user
+func (c *APIClient) UserUndel(uid string) (*bool, error) {
+	return apiRequest[bool, string](c, "user_undel", nil, uid)
+}
+
 func (c *APIClient) UserMod(uid string, options JSON) (*User, error) {
 	return apiRequest[User, string](c, "user_mod", options, uid)
 }
@@ -45,6 +101,43 @@ func (c *APIClient) UserUnlock(uid string) (*bool, error) {
 	return apiRequest[bool, string](c, "user_unlock", nil, uid)
 }
 
+// Returns the lockout status of the user on each server
+func (c *APIClient) UserStatus(uid string, options JSON) (*[]UserStatus, error) {
+	return apiRequest[[]UserStatus, string](c, "user_status", options, uid)
+}
+
 func (c *APIClient) UserFind(criteria string, options JSON) (*[]User, error) {
 	return apiRequest[[]User, string](c, "user_find", options, criteria)
 }
+
+func (c *APIClient) UserAddPrincipal(uid string, options JSON) (*User, error) {
+	return apiRequest[User, string](c, "user_add_principal", options, uid)
+}
+
+func (c *APIClient) UserRemovePrincipal(uid string, options JSON) (*User, error) {
+	return apiRequest[User, string](c, "user_remove_principal", options, uid)
+}
+
+func (c *APIClient) UserAddCert(uid string, options JSON) (*User, error) {
+	return apiRequest[User, string](c, "user_add_cert", options, uid)
+}
+
+func (c *APIClient) UserRemoveCert(uid string, options JSON) (*User, error) {
+	return apiRequest[User, string](c, "user_remove_cert", options, uid)
+}
+
+func (c *APIClient) UserAddPasskey(uid string, options JSON) (*User, error) {
+	return apiRequest[User, string](c, "user_add_passkey", options, uid)
+}
+
+func (c *APIClient) UserRemovePasskey(uid string, options JSON) (*User, error) {
+	return apiRequest[User, string](c, "user_remove_passkey", options, uid)
+}
+
+func (c *APIClient) UserAddCertMapData(uid string, options JSON) (*User, error) {
+	return apiRequest[User, string](c, "user_add_certmapdata", options, uid)
+}
+
+func (c *APIClient) UserRemoveCertMapData(uid string, options JSON) (*User, error) {
+	return apiRequest[User, string](c, "user_remove_certmapdata", options, uid)
+}
@@ -1,6 +1,7 @@
 package api
 
 import (
+	"encoding/json"
 	"strings"
 	"time"
 
@@ -60,3 +61,100 @@ func (s *Base64EncodedSecret) Decode() string {
 
 	return string(secret)
 }
+
+type DNSName struct {
+	string
+}
+
+func (n *DNSName) UnmarshalJSON(b []byte) (err error) {
+	var name struct {
+		Name string `json:"__dns_name__"`
+	}
+
+	err = json.Unmarshal(b, &name)
+	if err != nil {
+		return err
+	}
+
+	n.string = name.Name
+
+	return nil
+}
+
+func (n *DNSName) MarshalJSON() ([]byte, error) {
+	return json.Marshal(JSON{"__dns_name__": n.string})
+}
+
+func (n *DNSName) String() string {
+	return n.string
+}
+
+// Boolean attributes are returned either as JSON booleans or as "TRUE"/"FALSE"
+type IPABool struct {
+	bool
+}
+
+func (ipab *IPABool) UnmarshalJSON(b []byte) (err error) {
+	s := strings.Trim(string(b), "\"")
+	ipab.bool = strings.EqualFold(s, "true")
+
+	return nil
+}
+
+func (ipab *IPABool) MarshalJSON() ([]byte, error) {
+	return json.Marshal(ipab.bool)
+}
+
+func (ipab *IPABool) Bool() bool {
+	return ipab.bool
+}
+
+// Single-valued attributes are sometimes returned as a list and sometimes as a scalar
+type IPAValues []string
+
+func (v *IPAValues) UnmarshalJSON(b []byte) (err error) {
+	if strings.HasPrefix(string(b), "[") {
+		return json.Unmarshal(b, (*[]string)(v))
+	}
+
+	var value string
+	err = json.Unmarshal(b, &value)
+	if err != nil {
+		return err
+	}
+
+	*v = IPAValues{value}
+
+	return nil
+}
+
+// Dates that are returned either as {"__datetime__": ...} or as a placeholder such as "N/A"
+type IPAOptionalTime struct {
+	time.Time
+}
+
+func (ipat *IPAOptionalTime) UnmarshalJSON(b []byte) (err error) {
+	if !strings.HasPrefix(string(b), "{") {
+		return nil
+	}
+
+	var value struct {
+		DateTime IPATime `json:"__datetime__"`
+	}
+	err = json.Unmarshal(b, &value)
+	if err != nil {
+		return err
+	}
+
+	ipat.Time = value.DateTime.Time
+
+	return nil
+}
+
+func (ipat *IPAOptionalTime) String() string {
+	if ipat.Time.IsZero() {
+		return ""
+	}
+
+	return ipat.Time.Format(time.RFC3339)
+}
@@ -0,0 +1,109 @@
+package freeipa
+
+import (
+	"context"
+
+	api "terraform-provider-freeipa/freeipa/api"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func schemaPreservedUsers() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"users": {
+			Description: "Preserved users",
+			Type:        schema.TypeList,
+			Computed:    true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"uid": {
+						Description: "User UID (login)",
+						Type:        schema.TypeString,
+						Computed:    true,
+					},
+					"givenname": {
+						Description: "First name",
+						Type:        schema.TypeString,
+						Computed:    true,
+					},
+					"sn": {
+						Description: "Last name",
+						Type:        schema.TypeString,
+						Computed:    true,
+					},
+					"uidnumber": {
+						Description: "User ID number",
+						Type:        schema.TypeInt,
+						Computed:    true,
+					},
+					"mail": {
+						Description: "Email addresses",
+						Type:        schema.TypeList,
+						Computed:    true,
+						Elem: &schema.Schema{
+							Type: schema.TypeString,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourcePreservedUsers() *schema.Resource {
+	return &schema.Resource{
+		Description: "List FreeIPA preserved (deleted but restorable) users",
+		ReadContext: dataSourcePreservedUsersRead,
+		Schema:      schemaPreservedUsers(),
+	}
+}
+
+func dataSourcePreservedUsersRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	client := m.(*api.APIClient)
+	users, err := client.UserFind("", JSON{
+		"preserved": true,
+		"sizelimit": 0, // Do not truncate the results
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	flat := make([]JSON, 0, len(*users))
+	for i := range *users {
+		user := &(*users)[i]
+
+		item := JSON{
+			"uid":       user.UID[0],
+			"givenname": "",
+			"sn":        "",
+			"uidnumber": 0,
+			"mail":      make([]string, 0),
+		}
+		if len(user.GivenName) > 0 {
+			item["givenname"] = user.GivenName[0]
+		}
+		if len(user.SN) > 0 {
+			item["sn"] = user.SN[0]
+		}
+		if len(user.UIDNumber) > 0 {
+			uidnumber, _ := user.UIDNumber[0].Int64()
+			item["uidnumber"] = int(uidnumber)
+		}
+		if len(user.Mail) > 0 {
+			item["mail"] = user.Mail
+		}
+
+		flat = append(flat, item)
+	}
+
+	if err := d.Set("users", flat); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId("preserved_users")
+
+	return diags
+}
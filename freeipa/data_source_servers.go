@@ -0,0 +1,115 @@
+package freeipa
+
+import (
+	"context"
+	"sort"
+
+	api "terraform-provider-freeipa/freeipa/api"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func schemaServers() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"servers": {
+			Description: "FreeIPA servers",
+			Type:        schema.TypeList,
+			Computed:    true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"cn": {
+						Description: "Server name (FQDN)",
+						Type:        schema.TypeString,
+						Computed:    true,
+					},
+					"location": {
+						Description: "Location of the server",
+						Type:        schema.TypeString,
+						Computed:    true,
+					},
+					"serviceweight": {
+						Description: "Weight of the server's services in its location",
+						Type:        schema.TypeInt,
+						Computed:    true,
+					},
+					"roles": {
+						Description: "Roles enabled on the server",
+						Type:        schema.TypeList,
+						Computed:    true,
+						Elem: &schema.Schema{
+							Type: schema.TypeString,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceServers() *schema.Resource {
+	return &schema.Resource{
+		Description: "List FreeIPA servers and their roles",
+		ReadContext: dataSourceServersRead,
+		Schema:      schemaServers(),
+	}
+}
+
+func dataSourceServersRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	client := m.(*api.APIClient)
+	servers, err := client.ServerFind("", nil)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// Otherwise all the known roles are returned for every server, including the absent ones
+	roles, err := client.ServerRoleFind(JSON{
+		"status": "enabled",
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	serverRoles := make(map[string][]string)
+	for _, role := range *roles {
+		if role.Status != "enabled" {
+			continue
+		}
+		serverRoles[role.Server] = append(serverRoles[role.Server], role.Role)
+	}
+
+	flat := make([]JSON, 0, len(*servers))
+	for i := range *servers {
+		server := &(*servers)[i]
+
+		item := JSON{
+			"cn":            server.CN[0],
+			"location":      "",
+			"serviceweight": 0,
+			"roles":         make([]string, 0),
+		}
+		if len(server.Location) > 0 {
+			item["location"] = server.Location[0].String()
+		}
+		if len(server.ServiceWeight) > 0 {
+			weight, _ := server.ServiceWeight[0].Int64()
+			item["serviceweight"] = int(weight)
+		}
+		if names, ok := serverRoles[server.CN[0]]; ok {
+			sort.Strings(names)
+			item["roles"] = names
+		}
+
+		flat = append(flat, item)
+	}
+
+	if err := d.Set("servers", flat); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId("servers")
+
+	return diags
+}
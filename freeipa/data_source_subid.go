@@ -0,0 +1,87 @@
+package freeipa
+
+import (
+	"context"
+	"fmt"
+
+	api "terraform-provider-freeipa/freeipa/api"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func schemaDataSourceSubID() map[string]*schema.Schema {
+	subid := schemaSubID()
+
+	subid["owner"] = &schema.Schema{
+		Description:      "Owner of the subordinate IDs (user UID) to look up",
+		Type:             schema.TypeString,
+		Optional:         true,
+		Computed:         true,
+		ExactlyOneOf:     []string{"owner", "subuidnumber"},
+		ValidateDiagFunc: validation.ToDiagFunc(validation.StringIsNotWhiteSpace),
+	}
+	subid["subuidnumber"] = &schema.Schema{
+		Description:  "Subordinate user ID to look up (any ID of the range matches)\nIf not specified, this is the start value of the subordinate user ID range.",
+		Type:         schema.TypeInt,
+		Optional:     true,
+		Computed:     true,
+		ExactlyOneOf: []string{"owner", "subuidnumber"},
+	}
+	subid["description"] = &schema.Schema{
+		Description: "Subordinate ID description",
+		Type:        schema.TypeString,
+		Computed:    true,
+	}
+
+	return subid
+}
+
+func dataSourceSubID() *schema.Resource {
+	return &schema.Resource{
+		Description: "Look up the subordinate IDs of a FreeIPA user",
+		ReadContext: dataSourceSubIDRead,
+		Schema:      schemaDataSourceSubID(),
+	}
+}
+
+func dataSourceSubIDRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	client := m.(*api.APIClient)
+
+	var subids *[]api.SubID
+	var err error
+	if owner, ok := d.GetOk("owner"); ok {
+		subids, err = client.SubIDFind("", JSON{
+			"ipaowner": owner.(string),
+		})
+	} else {
+		subids, err = client.SubIDMatch(JSON{
+			"ipasubuidnumber": d.Get("subuidnumber").(int),
+		})
+	}
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if len(*subids) == 0 {
+		return diag.FromErr(fmt.Errorf("no subordinate IDs found"))
+	}
+
+	subid := &(*subids)[0]
+	for key, value := range flattenSubID(subid) {
+		// Keep the looked up value, it may not be the start of the range
+		if _, ok := d.GetOk(key); ok && key == "subuidnumber" {
+			continue
+		}
+		if err := d.Set(key, value); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	d.SetId(subid.UniqueID[0])
+
+	return diags
+}
@@ -0,0 +1,164 @@
+package freeipa
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	api "terraform-provider-freeipa/freeipa/api"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func schemaTopology() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"suffixes": {
+			Description: "Verification results of each topology suffix",
+			Type:        schema.TypeList,
+			Computed:    true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"cn": {
+						Description: "Suffix name",
+						Type:        schema.TypeString,
+						Computed:    true,
+					},
+					"in_order": {
+						Description: "Whether the replication topology of the suffix is correct",
+						Type:        schema.TypeBool,
+						Computed:    true,
+					},
+					"connect_errors": {
+						Description: "Servers unable to replicate to other servers",
+						Type:        schema.TypeList,
+						Computed:    true,
+						Elem: &schema.Resource{
+							Schema: map[string]*schema.Schema{
+								"server": {
+									Description: "Server name (FQDN)",
+									Type:        schema.TypeString,
+									Computed:    true,
+								},
+								"unreachable": {
+									Description: "Servers not reachable from this server",
+									Type:        schema.TypeList,
+									Computed:    true,
+									Elem: &schema.Schema{
+										Type: schema.TypeString,
+									},
+								},
+							},
+						},
+					},
+					"max_agmts_errors": {
+						Description: "Servers with more replication agreements than recommended",
+						Type:        schema.TypeList,
+						Computed:    true,
+						Elem: &schema.Resource{
+							Schema: map[string]*schema.Schema{
+								"server": {
+									Description: "Server name (FQDN)",
+									Type:        schema.TypeString,
+									Computed:    true,
+								},
+								"partners": {
+									Description: "Replication partners of this server",
+									Type:        schema.TypeList,
+									Computed:    true,
+									Elem: &schema.Schema{
+										Type: schema.TypeString,
+									},
+								},
+							},
+						},
+					},
+					"max_agmts": {
+						Description: "Recommended maximum number of replication agreements per server",
+						Type:        schema.TypeInt,
+						Computed:    true,
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceTopology() *schema.Resource {
+	return &schema.Resource{
+		Description: "Verify the FreeIPA replication topology\nTopology errors are reported as warnings.",
+		ReadContext: dataSourceTopologyRead,
+		Schema:      schemaTopology(),
+	}
+}
+
+func flattenTopologyVerification(cn string, verification *api.TopologyVerification) JSON {
+	connectErrors := make([]JSON, 0, len(verification.ConnectErrors))
+	for _, connectError := range verification.ConnectErrors {
+		connectErrors = append(connectErrors, JSON{
+			"server":      connectError.Server,
+			"unreachable": connectError.Unreachable,
+		})
+	}
+
+	maxAgmtsErrors := make([]JSON, 0, len(verification.MaxAgmtsErrors))
+	for _, maxAgmtsError := range verification.MaxAgmtsErrors {
+		maxAgmtsErrors = append(maxAgmtsErrors, JSON{
+			"server":   maxAgmtsError.Server,
+			"partners": maxAgmtsError.Partners,
+		})
+	}
+
+	return JSON{
+		"cn":               cn,
+		"in_order":         verification.InOrder,
+		"connect_errors":   connectErrors,
+		"max_agmts_errors": maxAgmtsErrors,
+		"max_agmts":        verification.MaxAgmts,
+	}
+}
+
+func dataSourceTopologyRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	client := m.(*api.APIClient)
+	suffixes, err := client.TopologySuffixFind("", nil)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	flat := make([]JSON, 0, len(*suffixes))
+	for _, suffix := range *suffixes {
+		cn := suffix.CN[0]
+
+		verification, err := client.TopologySuffixVerify(cn, nil)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		for _, connectError := range verification.ConnectErrors {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Warning,
+				Summary:  fmt.Sprintf("Replication topology problem in suffix %q", cn),
+				Detail:   fmt.Sprintf("Server %s cannot replicate to: %s", connectError.Server, strings.Join(connectError.Unreachable, ", ")),
+			})
+		}
+		for _, maxAgmtsError := range verification.MaxAgmtsErrors {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Warning,
+				Summary:  fmt.Sprintf("Replication topology problem in suffix %q", cn),
+				Detail:   fmt.Sprintf("Server %s has more than the recommended %d replication agreements: %s", maxAgmtsError.Server, verification.MaxAgmts, strings.Join(maxAgmtsError.Partners, ", ")),
+			})
+		}
+
+		flat = append(flat, flattenTopologyVerification(cn, verification))
+	}
+
+	if err := d.Set("suffixes", flat); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId("topology")
+
+	return diags
+}
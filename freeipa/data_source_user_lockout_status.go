@@ -0,0 +1,149 @@
+package freeipa
+
+import (
+	"context"
+	"sort"
+	"strconv"
+	"time"
+
+	api "terraform-provider-freeipa/freeipa/api"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func schemaUserLockoutStatus() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"uid": {
+			Description:      "User login",
+			Type:             schema.TypeString,
+			Required:         true,
+			ValidateDiagFunc: validation.ToDiagFunc(validation.StringIsNotWhiteSpace),
+		},
+		"lockout_status": {
+			Description: "Lockout status of the user account on each server",
+			Type:        schema.TypeList,
+			Computed:    true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"server": {
+						Description: "Server name (FQDN)",
+						Type:        schema.TypeString,
+						Computed:    true,
+					},
+					"krbloginfailedcount": {
+						Description: "Number of consecutive failed authentications",
+						Type:        schema.TypeInt,
+						Computed:    true,
+					},
+					"krblastfailedauth": {
+						Description: "Date of the last failed authentication (in RFC3339 format)",
+						Type:        schema.TypeString,
+						Computed:    true,
+					},
+					"krblastsuccessfulauth": {
+						Description: "Date of the last successful authentication (in RFC3339 format)",
+						Type:        schema.TypeString,
+						Computed:    true,
+					},
+					"locked": {
+						Description: "Whether the number of consecutive failed authentications on this server reached the maximum allowed by the password policy of the user\nThe account is unlocked automatically at locked_until, if set.",
+						Type:        schema.TypeBool,
+						Computed:    true,
+					},
+					"locked_until": {
+						Description: "Date at which the account is automatically unlocked (in RFC3339 format), which may be in the past\nEmpty if the account is not locked or if it has to be unlocked by an administrator.",
+						Type:        schema.TypeString,
+						Computed:    true,
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceUserLockoutStatus() *schema.Resource {
+	return &schema.Resource{
+		Description: "Look up the lockout status of a FreeIPA user on each server\nThe status is queried on every server of the topology, the user can be unlocked with the unlock_trigger attribute of freeipa_user.",
+		ReadContext: dataSourceUserLockoutStatusRead,
+		Schema:      schemaUserLockoutStatus(),
+	}
+}
+
+func flattenUserLockoutStatus(statuses []api.UserStatus, policy *api.PwPolicy) []JSON {
+	var maxFailure, lockoutDuration int64
+	if len(policy.MaxFailure) > 0 {
+		maxFailure, _ = policy.MaxFailure[0].Int64()
+	}
+	if len(policy.LockoutDuration) > 0 {
+		lockoutDuration, _ = policy.LockoutDuration[0].Int64()
+	}
+
+	sort.Slice(statuses, func(i, j int) bool {
+		return statuses[i].Server < statuses[j].Server
+	})
+
+	flat := make([]JSON, 0, len(statuses))
+	for _, status := range statuses {
+		failedCount := 0
+		if len(status.KrbLoginFailedCount) > 0 {
+			failedCount, _ = strconv.Atoi(status.KrbLoginFailedCount[0])
+		}
+
+		var lastFailed, lastSuccessful api.IPAOptionalTime
+		if len(status.KrbLastFailedAuth) > 0 {
+			lastFailed = status.KrbLastFailedAuth[0]
+		}
+		if len(status.KrbLastSuccessfulAuth) > 0 {
+			lastSuccessful = status.KrbLastSuccessfulAuth[0]
+		}
+
+		locked := false
+		lockedUntil := ""
+		if maxFailure > 0 && int64(failedCount) >= maxFailure {
+			locked = true
+			if lockoutDuration > 0 { // Otherwise locked until unlocked by an administrator
+				lockedUntil = lastFailed.Add(time.Duration(lockoutDuration) * time.Second).Format(time.RFC3339)
+			}
+		}
+
+		flat = append(flat, JSON{
+			"server":                status.Server,
+			"krbloginfailedcount":   failedCount,
+			"krblastfailedauth":     lastFailed.String(),
+			"krblastsuccessfulauth": lastSuccessful.String(),
+			"locked":                locked,
+			"locked_until":          lockedUntil,
+		})
+	}
+
+	return flat
+}
+
+func dataSourceUserLockoutStatusRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	client := m.(*api.APIClient)
+	uid := d.Get("uid").(string)
+
+	statuses, err := client.UserStatus(uid, nil)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	policy, err := client.PwPolicyShow(JSON{
+		"user": uid, // Policy that applies to the user
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := d.Set("lockout_status", flattenUserLockoutStatus(*statuses, policy)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(uid)
+
+	return diags
+}
@@ -30,13 +30,34 @@ func Provider() *schema.Provider {
 			},
 		},
 		ResourcesMap: map[string]*schema.Resource{
-			"freeipa_user":             resourceUser(),
-			"freeipa_group":            resourceGroup(),
-			"freeipa_service":          resourceService(),
-			"freeipa_idp":              resourceIdentityProvider(),
-			"freeipa_group_membership": resourceGroupMembership(),
+			"freeipa_user":                      resourceUser(),
+			"freeipa_group":                     resourceGroup(),
+			"freeipa_service":                   resourceService(),
+			"freeipa_idp":                       resourceIdentityProvider(),
+			"freeipa_group_membership":          resourceGroupMembership(),
+			"freeipa_group_members":             resourceGroupMembers(),
+			"freeipa_location":                  resourceLocation(),
+			"freeipa_server_location":           resourceServerLocation(),
+			"freeipa_realm_domains":             resourceRealmDomains(),
+			"freeipa_config":                    resourceConfig(),
+			"freeipa_stage_user":                resourceStageUser(),
+			"freeipa_subid":                     resourceSubID(),
+			"freeipa_passkey_config":            resourcePasskeyConfig(),
+			"freeipa_certmap_rule":              resourceCertMapRule(),
+			"freeipa_certmap_config":            resourceCertMapConfig(),
+			"freeipa_topology_segment":          resourceTopologySegment(),
+			"freeipa_service_delegation_rule":   resourceServiceDelegationRule(),
+			"freeipa_service_delegation_target": resourceServiceDelegationTarget(),
+			"freeipa_dns_zone_permission":       resourceDNSZonePermission(),
+			"freeipa_dns_server":                resourceDNSServer(),
+		},
+		DataSourcesMap: map[string]*schema.Resource{
+			"freeipa_servers":             dataSourceServers(),
+			"freeipa_subid":               dataSourceSubID(),
+			"freeipa_topology":            dataSourceTopology(),
+			"freeipa_preserved_users":     dataSourcePreservedUsers(),
+			"freeipa_user_lockout_status": dataSourceUserLockoutStatus(),
 		},
-		DataSourcesMap:       map[string]*schema.Resource{},
 		ConfigureContextFunc: providerConfigure,
 	}
 }
@@ -0,0 +1,106 @@
+package freeipa
+
+import (
+	"context"
+
+	api "terraform-provider-freeipa/freeipa/api"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// The certificate identity mapping configuration is a singleton, there is only one per realm
+const certMapConfigID = "certmapconfig"
+
+func schemaCertMapConfig() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"promptusername": {
+			Description: "Prompt for the username when multiple identities are mapped to a certificate",
+			Type:        schema.TypeBool,
+			Required:    true,
+		},
+	}
+}
+
+func resourceCertMapConfig() *schema.Resource {
+	return &schema.Resource{
+		Description:   "Manage the FreeIPA certificate identity mapping configuration\nThere is only one certificate identity mapping configuration, destroying this resource leaves it untouched.",
+		CreateContext: resourceCertMapConfigCreate,
+		ReadContext:   resourceCertMapConfigRead,
+		UpdateContext: resourceCertMapConfigUpdate,
+		DeleteContext: resourceCertMapConfigDelete,
+		Schema:        schemaCertMapConfig(),
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+func flattenCertMapConfig(config *api.CertMapConfig) JSON {
+	flat := JSON{
+		"promptusername": false,
+	}
+
+	if len(config.PromptUsername) > 0 {
+		flat["promptusername"] = config.PromptUsername[0].Bool()
+	}
+
+	return flat
+}
+
+func resourceCertMapConfigCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*api.APIClient)
+
+	_, err := client.CertMapConfigMod(JSON{
+		"ipacertmappromptusername": d.Get("promptusername").(bool),
+	})
+	if err != nil {
+		if apiErr, ok := err.(*api.APIError); !ok || apiErr.Code != 4202 { // Configuration already up to date
+			return diag.FromErr(err)
+		}
+	}
+
+	d.SetId(certMapConfigID)
+
+	return resourceCertMapConfigRead(ctx, d, m)
+}
+
+func resourceCertMapConfigRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	client := m.(*api.APIClient)
+	config, err := client.CertMapConfigShow(nil)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	for key, value := range flattenCertMapConfig(config) {
+		if err := d.Set(key, value); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return diags
+}
+
+func resourceCertMapConfigUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*api.APIClient)
+
+	if d.HasChange("promptusername") {
+		_, err := client.CertMapConfigMod(JSON{
+			"ipacertmappromptusername": d.Get("promptusername").(bool),
+		})
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return resourceCertMapConfigRead(ctx, d, m)
+}
+
+// The certificate identity mapping configuration cannot be deleted, only forget about it
+func resourceCertMapConfigDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	return diags
+}
@@ -0,0 +1,226 @@
+package freeipa
+
+import (
+	"context"
+
+	api "terraform-provider-freeipa/freeipa/api"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func schemaCertMapRule() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"cn": {
+			Description:      "Certificate identity mapping rule name",
+			Type:             schema.TypeString,
+			ForceNew:         true,
+			Required:         true,
+			ValidateDiagFunc: validation.ToDiagFunc(validation.StringIsNotWhiteSpace),
+		},
+		"description": {
+			Description: "Certificate identity mapping rule description",
+			Type:        schema.TypeString,
+			Optional:    true,
+		},
+		"maprule": {
+			Description:      "Rule used to map the certificate with a user entry",
+			Type:             schema.TypeString,
+			Optional:         true,
+			ValidateDiagFunc: validation.ToDiagFunc(validation.StringIsNotWhiteSpace),
+		},
+		"matchrule": {
+			Description:      "Rule used to check if a certificate can be used for authentication",
+			Type:             schema.TypeString,
+			Optional:         true,
+			ValidateDiagFunc: validation.ToDiagFunc(validation.StringIsNotWhiteSpace),
+		},
+		"domains": {
+			Description: "Domains where the user entry is searched",
+			Type:        schema.TypeSet,
+			Optional:    true,
+			Elem: &schema.Schema{
+				Type:             schema.TypeString,
+				ValidateDiagFunc: validation.ToDiagFunc(validation.StringIsNotWhiteSpace),
+			},
+		},
+		"priority": {
+			Description:      "Rule priority (lower number means higher priority)",
+			Type:             schema.TypeInt,
+			Optional:         true,
+			ValidateDiagFunc: validation.ToDiagFunc(validation.IntAtLeast(0)),
+		},
+		"enabled": {
+			Description: "Whether the rule is enabled",
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Default:     true,
+		},
+	}
+}
+
+func resourceCertMapRule() *schema.Resource {
+	return &schema.Resource{
+		Description:   "Manage FreeIPA certificate identity mapping rules",
+		CreateContext: resourceCertMapRuleCreate,
+		ReadContext:   resourceCertMapRuleRead,
+		UpdateContext: resourceCertMapRuleUpdate,
+		DeleteContext: resourceCertMapRuleDelete,
+		Schema:        schemaCertMapRule(),
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+func flattenCertMapRule(rule *api.CertMapRule) JSON {
+	flat := JSON{
+		"cn":          rule.CN[0],
+		"description": "",
+		"maprule":     "",
+		"matchrule":   "",
+		"domains":     make([]string, 0),
+		"priority":    0,
+		"enabled":     false,
+	}
+
+	if len(rule.Description) > 0 {
+		flat["description"] = rule.Description[0]
+	}
+	if len(rule.MapRule) > 0 {
+		flat["maprule"] = rule.MapRule[0]
+	}
+	if len(rule.MatchRule) > 0 {
+		flat["matchrule"] = rule.MatchRule[0]
+	}
+	if len(rule.AssociatedDomain) > 0 {
+		flat["domains"] = rule.AssociatedDomain
+	}
+	if len(rule.Priority) > 0 {
+		priority, _ := rule.Priority[0].Int64()
+		flat["priority"] = int(priority)
+	}
+	if len(rule.Enabled) > 0 {
+		flat["enabled"] = rule.Enabled[0].Bool()
+	}
+
+	return flat
+}
+
+func resourceCertMapRuleCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*api.APIClient)
+
+	options := JSON{}
+	if val, ok := d.GetOk("description"); ok {
+		options["description"] = val.(string)
+	}
+	if val, ok := d.GetOk("maprule"); ok {
+		options["ipacertmapmaprule"] = val.(string)
+	}
+	if val, ok := d.GetOk("matchrule"); ok {
+		options["ipacertmapmatchrule"] = val.(string)
+	}
+	if val, ok := d.GetOk("domains"); ok {
+		options["associateddomain"] = val.(*schema.Set).List()
+	}
+	if val, ok := d.GetOk("priority"); ok {
+		options["ipacertmappriority"] = val.(int)
+	}
+
+	rule, err := client.CertMapRuleAdd(d.Get("cn").(string), options)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(rule.CN[0])
+
+	if !d.Get("enabled").(bool) {
+		_, err := client.CertMapRuleDisable(d.Id())
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return resourceCertMapRuleRead(ctx, d, m)
+}
+
+func resourceCertMapRuleRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	client := m.(*api.APIClient)
+	rule, err := client.CertMapRuleShow(d.Id(), nil)
+	if err != nil {
+		if err.(*api.APIError).Code == 4001 { // Rule not found
+			d.SetId("")
+			return diags
+		}
+		return diag.FromErr(err)
+	}
+
+	for key, value := range flattenCertMapRule(rule) {
+		if err := d.Set(key, value); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return diags
+}
+
+func resourceCertMapRuleUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*api.APIClient)
+
+	options := JSON{}
+	if d.HasChange("description") {
+		options["description"] = d.Get("description").(string)
+	}
+	if d.HasChange("maprule") {
+		options["ipacertmapmaprule"] = d.Get("maprule").(string)
+	}
+	if d.HasChange("matchrule") {
+		options["ipacertmapmatchrule"] = d.Get("matchrule").(string)
+	}
+	if d.HasChange("domains") {
+		options["associateddomain"] = d.Get("domains").(*schema.Set).List()
+	}
+	if d.HasChange("priority") {
+		if val, ok := d.GetOk("priority"); ok {
+			options["ipacertmappriority"] = val.(int)
+		} else {
+			options["ipacertmappriority"] = ""
+		}
+	}
+
+	if len(options) > 0 {
+		_, err := client.CertMapRuleMod(d.Id(), options)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	if d.HasChange("enabled") {
+		var err error
+		if d.Get("enabled").(bool) {
+			_, err = client.CertMapRuleEnable(d.Id())
+		} else {
+			_, err = client.CertMapRuleDisable(d.Id())
+		}
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return resourceCertMapRuleRead(ctx, d, m)
+}
+
+func resourceCertMapRuleDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	client := m.(*api.APIClient)
+	_, err := client.CertMapRuleDel(d.Id(), nil)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diags
+}
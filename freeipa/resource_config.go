@@ -0,0 +1,369 @@
+package freeipa
+
+import (
+	"context"
+	"strconv"
+	"strings"
+
+	api "terraform-provider-freeipa/freeipa/api"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// The global configuration is a singleton, there is only one per realm
+const configID = "config"
+
+// Attributes of the resource, mapped to the API attribute names
+var configAttributes = map[string]string{
+	"defaultloginshell":   "ipadefaultloginshell",
+	"homesrootdir":        "ipahomesrootdir",
+	"defaultprimarygroup": "ipadefaultprimarygroup",
+	"maxusernamelength":   "ipamaxusernamelength",
+	"searchrecordslimit":  "ipasearchrecordslimit",
+	"searchtimelimit":     "ipasearchtimelimit",
+	"defaultemaildomain":  "ipadefaultemaildomain",
+	"configstring":        "ipaconfigstring",
+	"userauthtype":        "ipauserauthtype",
+}
+
+func schemaConfig() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"defaultloginshell": {
+			Description:      "Default shell for new users",
+			Type:             schema.TypeString,
+			Optional:         true,
+			ValidateDiagFunc: validation.ToDiagFunc(validation.StringIsNotWhiteSpace),
+		},
+		"homesrootdir": {
+			Description:      "Default location of home directories",
+			Type:             schema.TypeString,
+			Optional:         true,
+			ValidateDiagFunc: validation.ToDiagFunc(validation.StringIsNotWhiteSpace),
+		},
+		"defaultprimarygroup": {
+			Description:      "Default group for new users",
+			Type:             schema.TypeString,
+			Optional:         true,
+			ValidateDiagFunc: validation.ToDiagFunc(validation.StringIsNotWhiteSpace),
+		},
+		"maxusernamelength": {
+			Description:      "Maximum username length",
+			Type:             schema.TypeInt,
+			Optional:         true,
+			ValidateDiagFunc: validation.ToDiagFunc(validation.IntBetween(1, 255)),
+		},
+		"searchrecordslimit": {
+			Description:      "Maximum number of records to search (-1 or 0 is unlimited)",
+			Type:             schema.TypeInt,
+			Optional:         true,
+			ValidateDiagFunc: validation.ToDiagFunc(validation.IntAtLeast(-1)),
+		},
+		"searchtimelimit": {
+			Description:      "Maximum amount of time (seconds) for a search (-1 or 0 is unlimited)",
+			Type:             schema.TypeInt,
+			Optional:         true,
+			ValidateDiagFunc: validation.ToDiagFunc(validation.IntAtLeast(-1)),
+		},
+		"defaultemaildomain": {
+			Description:      "Default e-mail domain",
+			Type:             schema.TypeString,
+			Optional:         true,
+			ValidateDiagFunc: validation.ToDiagFunc(validation.StringIsNotWhiteSpace),
+		},
+		"configstring": {
+			Description: `Password plugin features (must be among "AllowNThash", "KDC:Disable Last Success", "KDC:Disable Lockout" and "KDC:Disable Default Preauth for SPNs")`,
+			Type:        schema.TypeSet,
+			Optional:    true,
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
+				ValidateDiagFunc: validation.ToDiagFunc(validation.StringInSlice([]string{
+					"AllowNThash",
+					"KDC:Disable Last Success",
+					"KDC:Disable Lockout",
+					"KDC:Disable Default Preauth for SPNs",
+				}, false)),
+			},
+		},
+		"userauthtype": {
+			Description: `Default types of supported user authentication (must be among "password", "radius", "otp", "pkinit", "hardened", "idp", "passkey" and "disabled")`,
+			Type:        schema.TypeSet,
+			Optional:    true,
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
+				ValidateDiagFunc: validation.ToDiagFunc(validation.StringInSlice([]string{
+					"password", "radius", "otp", "pkinit", "hardened", "idp", "passkey", "disabled",
+				}, false)),
+			},
+		},
+		"restore_on_destroy": {
+			Description: "Restore the managed attributes to the values they had before being managed when the resource is destroyed (or when an attribute is removed from the configuration)\nIf not set, the attributes are left as they are.",
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Default:     false,
+		},
+		"original_values": {
+			Description: "Values of the managed attributes before they were managed by Terraform\nAfter an import, the original values are the ones the attributes have when they are first applied.",
+			Type:        schema.TypeMap,
+			Computed:    true,
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
+			},
+		},
+	}
+}
+
+func resourceConfig() *schema.Resource {
+	return &schema.Resource{
+		Description:   "Manage the FreeIPA global configuration\nOnly the attributes set in the configuration are managed, the other ones are left untouched.",
+		CreateContext: resourceConfigCreate,
+		ReadContext:   resourceConfigRead,
+		UpdateContext: resourceConfigUpdate,
+		DeleteContext: resourceConfigDelete,
+		Schema:        schemaConfig(),
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+func flattenConfig(config *api.Config) JSON {
+	flat := JSON{
+		"defaultloginshell":   "",
+		"homesrootdir":        "",
+		"defaultprimarygroup": "",
+		"maxusernamelength":   0,
+		"searchrecordslimit":  0,
+		"searchtimelimit":     0,
+		"defaultemaildomain":  "",
+		"configstring":        config.ConfigString,
+		"userauthtype":        config.UserAuthType,
+	}
+
+	if len(config.DefaultLoginShell) > 0 {
+		flat["defaultloginshell"] = config.DefaultLoginShell[0]
+	}
+	if len(config.HomesRootDir) > 0 {
+		flat["homesrootdir"] = config.HomesRootDir[0]
+	}
+	if len(config.DefaultPrimaryGroup) > 0 {
+		flat["defaultprimarygroup"] = config.DefaultPrimaryGroup[0]
+	}
+	if len(config.MaxUsernameLength) > 0 {
+		value, _ := config.MaxUsernameLength[0].Int64()
+		flat["maxusernamelength"] = int(value)
+	}
+	if len(config.SearchRecordsLimit) > 0 {
+		value, _ := config.SearchRecordsLimit[0].Int64()
+		flat["searchrecordslimit"] = int(value)
+	}
+	if len(config.SearchTimeLimit) > 0 {
+		value, _ := config.SearchTimeLimit[0].Int64()
+		flat["searchtimelimit"] = int(value)
+	}
+	if len(config.DefaultEmailDomain) > 0 {
+		flat["defaultemaildomain"] = config.DefaultEmailDomain[0]
+	}
+	if config.ConfigString == nil {
+		flat["configstring"] = make([]string, 0)
+	}
+	if config.UserAuthType == nil {
+		flat["userauthtype"] = make([]string, 0)
+	}
+
+	return flat
+}
+
+// Convert a flattened value to its representation in original_values
+func configValueToString(value interface{}) string {
+	switch v := value.(type) {
+	case []string:
+		return strings.Join(v, ",")
+	case int:
+		return strconv.Itoa(v)
+	default:
+		return v.(string)
+	}
+}
+
+// Values of the attributes as stored in original_values
+// Integers that are not set on the server are flattened to 0, they are kept empty so that they are cleared on restore
+func flattenConfigOriginals(config *api.Config) map[string]string {
+	originals := make(map[string]string)
+	for key, value := range flattenConfig(config) {
+		originals[key] = configValueToString(value)
+	}
+
+	unset := map[string]bool{
+		"maxusernamelength":  len(config.MaxUsernameLength) == 0,
+		"searchrecordslimit": len(config.SearchRecordsLimit) == 0,
+		"searchtimelimit":    len(config.SearchTimeLimit) == 0,
+	}
+	for key, missing := range unset {
+		if missing {
+			originals[key] = ""
+		}
+	}
+
+	return originals
+}
+
+// Convert a value of original_values back to an API option value
+func configStringToOption(key string, value string) interface{} {
+	if key == "configstring" || key == "userauthtype" {
+		if value == "" {
+			return make([]string, 0)
+		}
+		return strings.Split(value, ",")
+	}
+
+	return value
+}
+
+func configOption(d *schema.ResourceData, key string) interface{} {
+	switch value := d.Get(key).(type) {
+	case *schema.Set:
+		return value.List()
+	default:
+		return value
+	}
+}
+
+func resourceConfigCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*api.APIClient)
+
+	config, err := client.ConfigShow(nil)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	current := flattenConfigOriginals(config)
+
+	options := JSON{}
+	originals := make(map[string]string)
+	for key, name := range configAttributes {
+		if d.GetRawConfig().GetAttr(key).IsNull() {
+			continue
+		}
+
+		originals[key] = current[key]
+		options[name] = configOption(d, key)
+	}
+
+	_, err = client.ConfigMod(options)
+	if err != nil {
+		if apiErr, ok := err.(*api.APIError); !ok || apiErr.Code != 4202 { // Configuration already up to date
+			return diag.FromErr(err)
+		}
+	}
+
+	if err := d.Set("original_values", originals); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(configID)
+
+	return resourceConfigRead(ctx, d, m)
+}
+
+func resourceConfigRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	client := m.(*api.APIClient)
+	config, err := client.ConfigShow(nil)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// Only refresh the attributes managed by this resource
+	originals := d.Get("original_values").(map[string]interface{})
+	for key, value := range flattenConfig(config) {
+		if _, ok := originals[key]; !ok {
+			continue
+		}
+		if err := d.Set(key, value); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return diags
+}
+
+func resourceConfigUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*api.APIClient)
+
+	config, err := client.ConfigShow(nil)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	current := flattenConfigOriginals(config)
+
+	options := JSON{}
+	originals := make(map[string]string)
+	for key, value := range d.Get("original_values").(map[string]interface{}) {
+		originals[key] = value.(string)
+	}
+
+	for key, name := range configAttributes {
+		original, managed := originals[key]
+
+		if d.GetRawConfig().GetAttr(key).IsNull() {
+			// The attribute is not managed anymore
+			if managed {
+				if d.Get("restore_on_destroy").(bool) {
+					options[name] = configStringToOption(key, original)
+				}
+				delete(originals, key)
+			}
+			continue
+		}
+
+		if !managed {
+			originals[key] = current[key]
+		}
+		if !managed || d.HasChange(key) {
+			options[name] = configOption(d, key)
+		}
+	}
+
+	if len(options) > 0 {
+		_, err := client.ConfigMod(options)
+		if err != nil {
+			if apiErr, ok := err.(*api.APIError); !ok || apiErr.Code != 4202 { // Configuration already up to date
+				return diag.FromErr(err)
+			}
+		}
+	}
+
+	if err := d.Set("original_values", originals); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceConfigRead(ctx, d, m)
+}
+
+func resourceConfigDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if !d.Get("restore_on_destroy").(bool) {
+		return diags
+	}
+
+	client := m.(*api.APIClient)
+
+	options := JSON{}
+	for key, value := range d.Get("original_values").(map[string]interface{}) {
+		options[configAttributes[key]] = configStringToOption(key, value.(string))
+	}
+
+	if len(options) > 0 {
+		_, err := client.ConfigMod(options)
+		if err != nil {
+			if apiErr, ok := err.(*api.APIError); !ok || apiErr.Code != 4202 { // Configuration already restored
+				return diag.FromErr(err)
+			}
+		}
+	}
+
+	return diags
+}
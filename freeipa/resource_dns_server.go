@@ -0,0 +1,164 @@
+package freeipa
+
+import (
+	"context"
+
+	api "terraform-provider-freeipa/freeipa/api"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func schemaDNSServer() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"server": {
+			Description:      "DNS server name (FQDN)",
+			Type:             schema.TypeString,
+			ForceNew:         true,
+			Required:         true,
+			ValidateDiagFunc: validation.ToDiagFunc(validation.StringIsNotWhiteSpace),
+		},
+		"forwarders": {
+			Description: "Per-server forwarders (IP addresses, optionally followed by \" port <port>\")\nIf not specified, the global forwarders are used.",
+			Type:        schema.TypeList,
+			Optional:    true,
+			Elem: &schema.Schema{
+				Type:             schema.TypeString,
+				ValidateDiagFunc: validation.ToDiagFunc(validation.StringIsNotWhiteSpace),
+			},
+		},
+		"forwardpolicy": {
+			Description:      `Per-server forward policy (must be one of "only", "first" or "none")`,
+			Type:             schema.TypeString,
+			Optional:         true,
+			ValidateDiagFunc: validation.ToDiagFunc(validation.StringInSlice([]string{"only", "first", "none"}, false)),
+		},
+		"soamname": {
+			Description:      "SOA mname (authoritative server) override\nIf not specified, the server name is used.",
+			Type:             schema.TypeString,
+			Optional:         true,
+			ValidateDiagFunc: validation.ToDiagFunc(validation.StringIsNotWhiteSpace),
+		},
+	}
+}
+
+func resourceDNSServer() *schema.Resource {
+	return &schema.Resource{
+		Description:   "Manage the configuration of a FreeIPA DNS server\nDestroying this resource resets the configuration of the server.",
+		CreateContext: resourceDNSServerCreate,
+		ReadContext:   resourceDNSServerRead,
+		UpdateContext: resourceDNSServerUpdate,
+		DeleteContext: resourceDNSServerDelete,
+		Schema:        schemaDNSServer(),
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+func flattenDNSServer(server *api.DNSServer) JSON {
+	flat := JSON{
+		"server":        server.ServerID[0],
+		"forwarders":    make([]string, 0),
+		"forwardpolicy": "",
+		"soamname":      "",
+	}
+
+	if len(server.Forwarders) > 0 {
+		flat["forwarders"] = server.Forwarders
+	}
+	if len(server.ForwardPolicy) > 0 {
+		flat["forwardpolicy"] = server.ForwardPolicy[0]
+	}
+	if len(server.SOAMName) > 0 {
+		flat["soamname"] = server.SOAMName[0].String()
+	}
+
+	return flat
+}
+
+func resourceDNSServerCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*api.APIClient)
+
+	options := JSON{
+		"idnsforwarders":    d.Get("forwarders").([]interface{}),
+		"idnsforwardpolicy": d.Get("forwardpolicy").(string),
+		"idnssoamname":      d.Get("soamname").(string),
+	}
+
+	_, err := client.DNSServerMod(d.Get("server").(string), options)
+	if err != nil {
+		if apiErr, ok := err.(*api.APIError); !ok || apiErr.Code != 4202 { // Configuration already up to date
+			return diag.FromErr(err)
+		}
+	}
+
+	d.SetId(d.Get("server").(string))
+
+	return resourceDNSServerRead(ctx, d, m)
+}
+
+func resourceDNSServerRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	client := m.(*api.APIClient)
+	server, err := client.DNSServerShow(d.Id(), nil)
+	if err != nil {
+		if err.(*api.APIError).Code == 4001 { // DNS server not found
+			d.SetId("")
+			return diags
+		}
+		return diag.FromErr(err)
+	}
+
+	for key, value := range flattenDNSServer(server) {
+		if err := d.Set(key, value); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return diags
+}
+
+func resourceDNSServerUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*api.APIClient)
+
+	options := JSON{}
+	if d.HasChange("forwarders") {
+		options["idnsforwarders"] = d.Get("forwarders").([]interface{})
+	}
+	if d.HasChange("forwardpolicy") {
+		options["idnsforwardpolicy"] = d.Get("forwardpolicy").(string)
+	}
+	if d.HasChange("soamname") {
+		options["idnssoamname"] = d.Get("soamname").(string)
+	}
+
+	if len(options) > 0 {
+		_, err := client.DNSServerMod(d.Id(), options)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return resourceDNSServerRead(ctx, d, m)
+}
+
+func resourceDNSServerDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	client := m.(*api.APIClient)
+	_, err := client.DNSServerMod(d.Id(), JSON{
+		"idnsforwarders":    make([]string, 0),
+		"idnsforwardpolicy": "",
+		"idnssoamname":      "",
+	})
+	if err != nil {
+		if apiErr, ok := err.(*api.APIError); !ok || apiErr.Code != 4202 { // Configuration already reset
+			return diag.FromErr(err)
+		}
+	}
+
+	return diags
+}
@@ -0,0 +1,105 @@
+package freeipa
+
+import (
+	"context"
+	"strings"
+
+	api "terraform-provider-freeipa/freeipa/api"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func schemaDNSZonePermission() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"zone": {
+			Description:      "DNS zone name",
+			Type:             schema.TypeString,
+			ForceNew:         true,
+			Required:         true,
+			ValidateDiagFunc: validation.ToDiagFunc(validation.StringIsNotWhiteSpace),
+		},
+		"permission": {
+			Description: "Name of the permission allowing to manage the zone",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+	}
+}
+
+func resourceDNSZonePermission() *schema.Resource {
+	return &schema.Resource{
+		Description:   "Manage the permission allowing to manage a FreeIPA DNS zone\nThe permission can then be granted to a privilege.",
+		CreateContext: resourceDNSZonePermissionCreate,
+		ReadContext:   resourceDNSZonePermissionRead,
+		DeleteContext: resourceDNSZonePermissionDelete,
+		Schema:        schemaDNSZonePermission(),
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+// The permission is returned as a DN (cn=Manage DNS zone example.com.,cn=permissions,...)
+func permissionNameFromDN(dn string) string {
+	rdn, _, _ := strings.Cut(dn, ",")
+
+	return strings.TrimPrefix(rdn, "cn=")
+}
+
+func resourceDNSZonePermissionCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*api.APIClient)
+
+	_, err := client.DNSZoneAddPermission(d.Get("zone").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(d.Get("zone").(string))
+
+	return resourceDNSZonePermissionRead(ctx, d, m)
+}
+
+func resourceDNSZonePermissionRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	client := m.(*api.APIClient)
+	zone, err := client.DNSZoneShow(d.Id(), JSON{
+		"all": true, // Otherwise we don't get the permission
+	})
+	if err != nil {
+		if err.(*api.APIError).Code == 4001 { // Zone not found
+			d.SetId("")
+			return diags
+		}
+		return diag.FromErr(err)
+	}
+
+	// The permission has been removed
+	if len(zone.ManagedBy) == 0 {
+		d.SetId("")
+		return diags
+	}
+
+	if err := d.Set("zone", d.Id()); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("permission", permissionNameFromDN(zone.ManagedBy[0])); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diags
+}
+
+func resourceDNSZonePermissionDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	client := m.(*api.APIClient)
+	_, err := client.DNSZoneRemovePermission(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diags
+}
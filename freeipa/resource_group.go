@@ -2,6 +2,8 @@ package freeipa
 
 import (
 	"context"
+	"fmt"
+	"strings"
 
 	api "terraform-provider-freeipa/freeipa/api"
 
@@ -13,9 +15,8 @@ import (
 func schemaGroup() map[string]*schema.Schema {
 	return map[string]*schema.Schema{
 		"cn": {
-			Description: "Group name",
+			Description: "Group name\nRenaming the group keeps its members and GID.",
 			Type:        schema.TypeString,
-			ForceNew:    true,
 			Required:    true,
 			ValidateDiagFunc: validation.ToDiagFunc(validation.All(
 				validation.StringIsNotWhiteSpace,
@@ -28,6 +29,21 @@ func schemaGroup() map[string]*schema.Schema {
 			Type:        schema.TypeString,
 			Optional:    true,
 		},
+		"type": {
+			Description:      `Group type (must be one of "posix", "nonposix" or "external")\nA non-POSIX group can be converted to a POSIX or external group, any other change replaces the group.`,
+			Type:             schema.TypeString,
+			Optional:         true,
+			Default:          "posix",
+			ValidateDiagFunc: validation.ToDiagFunc(validation.StringInSlice([]string{"posix", "nonposix", "external"}, false)),
+		},
+		"gidnumber": {
+			Description:      "Group ID number (only for POSIX groups)\nIf not specified, a number will be automatically assigned.",
+			Type:             schema.TypeInt,
+			Optional:         true,
+			Computed:         true,
+			ValidateDiagFunc: validation.ToDiagFunc(validation.IntAtLeast(1)),
+		},
+		"extra_attributes": schemaExtraAttributes("cn", "description", "gidnumber"),
 	}
 }
 
@@ -39,6 +55,7 @@ func resourceGroup() *schema.Resource {
 		UpdateContext: resourceGroupUpdate,
 		DeleteContext: resourceGroupDelete,
 		Schema:        schemaGroup(),
+		CustomizeDiff: resourceGroupCustomizeDiff,
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
@@ -54,30 +71,83 @@ func flattenGroup(group *api.Group) JSON {
 		flat["description"] = group.Description[0]
 	}
 
+	if len(group.GIDNumber) > 0 {
+		gidnumber, _ := group.GIDNumber[0].Int64()
+		flat["gidnumber"] = int(gidnumber)
+	} else {
+		flat["gidnumber"] = 0
+	}
+
+	// The type of a group is given by its object classes
+	flat["type"] = "nonposix"
+	for _, objectClass := range group.ObjectClass {
+		switch strings.ToLower(objectClass) {
+		case "posixgroup":
+			flat["type"] = "posix"
+		case "ipaexternalgroup":
+			flat["type"] = "external"
+		}
+	}
+
 	return flat
 }
 
-func resourceGroupCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	var diags diag.Diagnostics
+// Only non-POSIX groups can be converted (to POSIX or external groups)
+func resourceGroupCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+	if d.Get("type").(string) != "posix" && !d.GetRawConfig().GetAttr("gidnumber").IsNull() {
+		return fmt.Errorf("gidnumber can only be set on POSIX groups")
+	}
+
+	if d.Id() == "" || !d.HasChange("type") {
+		return nil
+	}
+
+	oldType, newType := d.GetChange("type")
+	if oldType.(string) != "nonposix" {
+		return d.ForceNew("type")
+	}
+
+	// A GID is assigned when the group is converted to a POSIX group
+	if newType.(string) == "posix" && d.GetRawConfig().GetAttr("gidnumber").IsNull() {
+		return d.SetNewComputed("gidnumber")
+	}
+
+	return nil
+}
 
+func resourceGroupCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	client := m.(*api.APIClient)
-	group, err := client.GroupAdd(d.Get("cn").(string), JSON{
+	options := JSON{
 		"description": d.Get("description").(string),
-	})
+	}
+	switch d.Get("type").(string) {
+	case "nonposix":
+		options["nonposix"] = true
+	case "external":
+		options["external"] = true
+	}
+	if gidnumber, ok := d.GetOk("gidnumber"); ok {
+		options["gidnumber"] = gidnumber.(int)
+	}
+	expandExtraAttributes(d, options)
+
+	group, err := client.GroupAdd(d.Get("cn").(string), options)
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
 	d.SetId(group.CN[0])
 
-	return diags
+	return resourceGroupRead(ctx, d, m)
 }
 
 func resourceGroupRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
 
 	client := m.(*api.APIClient)
-	group, err := client.GroupShow(d.Id(), nil)
+	group, err := client.GroupShow(d.Id(), JSON{
+		"all": true, // Otherwise we don't get the object classes
+	})
 	if err != nil {
 		if err.(*api.APIError).Code == 4001 { // Group not found
 			d.SetId("")
@@ -92,6 +162,10 @@ func resourceGroupRead(ctx context.Context, d *schema.ResourceData, m interface{
 		}
 	}
 
+	if err := readExtraAttributes(d, client, "group_show"); err != nil {
+		return diag.FromErr(err)
+	}
+
 	return diags
 }
 
@@ -99,17 +173,35 @@ func resourceGroupUpdate(ctx context.Context, d *schema.ResourceData, m interfac
 	client := m.(*api.APIClient)
 
 	options := JSON{}
+	if d.HasChange("cn") {
+		options["rename"] = d.Get("cn").(string)
+	}
 	if d.HasChange("description") {
 		options["description"] = d.Get("description").(string)
 	}
+	if d.HasChange("type") {
+		// Conversion of a non-POSIX group, the other changes force a replacement
+		options[d.Get("type").(string)] = true
+	}
+	if d.HasChange("gidnumber") {
+		options["gidnumber"] = d.Get("gidnumber").(int)
+	}
+	if d.HasChange("extra_attributes") {
+		expandExtraAttributes(d, options)
+	}
 
-	if d.HasChangeExcept("cn") {
+	if len(options) > 0 {
 		_, err := client.GroupMod(d.Id(), options)
 		if err != nil {
 			return diag.FromErr(err)
 		}
 	}
 
+	// If the CN changed, update the ID
+	if d.HasChange("cn") {
+		d.SetId(d.Get("cn").(string))
+	}
+
 	return resourceGroupRead(ctx, d, m)
 }
 
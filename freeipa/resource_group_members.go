@@ -0,0 +1,280 @@
+package freeipa
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	api "terraform-provider-freeipa/freeipa/api"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// Member types of a group, mapped to the API option names
+var groupMemberTypes = []struct {
+	key         string // Attribute name in the schema
+	option      string // Option name in the API
+	description string
+}{
+	{"users", "user", "User members (logins)"},
+	{"groups", "group", "Group members (names)"},
+	{"services", "service", "Service members (principals in the form of service/host_fqdn@REALM)"},
+	{"idoverrideusers", "idoverrideuser", "User ID override members (in the form of user@domain)"},
+	{"externalmembers", "ipaexternalmember", "External members (SIDs of trusted domain objects, for external groups only)"},
+}
+
+func schemaGroupMembers() map[string]*schema.Schema {
+	members := map[string]*schema.Schema{
+		"group": {
+			Description: "Group name (CN)",
+			Type:        schema.TypeString,
+			Required:    true,
+			ForceNew:    true,
+			ValidateDiagFunc: validation.ToDiagFunc(validation.All(
+				validation.StringIsNotWhiteSpace,
+				StringContainsNoUpperLetter,
+				StringIsNotOnlyDigits,
+			)),
+		},
+	}
+
+	for _, memberType := range groupMemberTypes {
+		members[memberType.key] = &schema.Schema{
+			Description: memberType.description,
+			Type:        schema.TypeSet,
+			Optional:    true,
+			Elem: &schema.Schema{
+				Type:             schema.TypeString,
+				ValidateDiagFunc: validation.ToDiagFunc(validation.StringIsNotWhiteSpace),
+			},
+		}
+	}
+
+	return members
+}
+
+func resourceGroupMembers() *schema.Resource {
+	return &schema.Resource{
+		Description:   "Manage the complete list of members of a FreeIPA group\nMembers added outside of Terraform are removed, and destroying the resource removes all the members. This resource should not be used along with freeipa_group_membership on the same group.",
+		CreateContext: resourceGroupMembersCreate,
+		ReadContext:   resourceGroupMembersRead,
+		UpdateContext: resourceGroupMembersUpdate,
+		DeleteContext: resourceGroupMembersDelete,
+		Schema:        schemaGroupMembers(),
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+// Direct members of a group, by API option name
+func groupDirectMembers(group *api.Group) map[string][]string {
+	return map[string][]string{
+		"user":              group.MemberUser,
+		"group":             group.MemberGroup,
+		"service":           group.MemberService,
+		"idoverrideuser":    group.MemberIDOverrideUser,
+		"ipaexternalmember": group.ExternalMember,
+	}
+}
+
+// Members of the configuration, by API option name
+func expandGroupMembers(d *schema.ResourceData) map[string][]string {
+	members := make(map[string][]string)
+	for _, memberType := range groupMemberTypes {
+		values := make([]string, 0)
+		for _, value := range d.Get(memberType.key).(*schema.Set).List() {
+			values = append(values, value.(string))
+		}
+		members[memberType.option] = values
+	}
+
+	return members
+}
+
+// Services are returned with their realm, either value may be the configured one
+func groupMembersEqual(value string, other string) bool {
+	return groupMemberMatches(value, other) || groupMemberMatches(other, value)
+}
+
+// Values of wanted that are not in current, compared case insensitively and with or without the realm of services
+func groupMembersDifference(wanted []string, current []string) []string {
+	difference := make([]string, 0)
+	for _, value := range wanted {
+		found := false
+		for _, other := range current {
+			if groupMembersEqual(value, other) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			difference = append(difference, value)
+		}
+	}
+
+	return difference
+}
+
+// Members of the group, spelled as in the configuration when they match a configured member
+func groupMembersAsConfigured(current []string, configured []string) []string {
+	members := make([]string, 0, len(current))
+	for _, value := range current {
+		for _, other := range configured {
+			if groupMembersEqual(value, other) {
+				value = other
+				break
+			}
+		}
+		members = append(members, value)
+	}
+
+	return members
+}
+
+// Bring the members of the group in line with the configuration, with one call to remove and one call to add members
+// The API does not report members that could not be added or removed as errors, so the result is checked afterwards
+func applyGroupMembers(client *api.APIClient, cn string, wanted map[string][]string) error {
+	group, err := client.GroupShow(cn, JSON{
+		"all": true, // Otherwise we don't get the external members
+	})
+	if err != nil {
+		return err
+	}
+	current := groupDirectMembers(group)
+
+	removed := JSON{}
+	added := JSON{}
+	for option, values := range wanted {
+		if difference := groupMembersDifference(current[option], values); len(difference) > 0 {
+			removed[option] = difference
+		}
+		if difference := groupMembersDifference(values, current[option]); len(difference) > 0 {
+			added[option] = difference
+		}
+	}
+
+	if len(removed) > 0 {
+		_, err := client.GroupRemoveMember(cn, removed)
+		if err != nil {
+			return err
+		}
+	}
+	if len(added) > 0 {
+		_, err := client.GroupAddMember(cn, added)
+		if err != nil {
+			return err
+		}
+	}
+	if len(removed) == 0 && len(added) == 0 {
+		return nil
+	}
+
+	group, err = client.GroupShow(cn, JSON{
+		"all": true,
+	})
+	if err != nil {
+		return err
+	}
+	current = groupDirectMembers(group)
+
+	problems := make([]string, 0)
+	for option, values := range wanted {
+		for _, value := range groupMembersDifference(values, current[option]) {
+			problems = append(problems, fmt.Sprintf("%s %q could not be added", option, value))
+		}
+		for _, value := range groupMembersDifference(current[option], values) {
+			problems = append(problems, fmt.Sprintf("%s %q could not be removed", option, value))
+		}
+	}
+	if len(problems) > 0 {
+		sort.Strings(problems)
+		return fmt.Errorf("members of group %q are not in line with the configuration: %s", cn, strings.Join(problems, ", "))
+	}
+
+	return nil
+}
+
+func resourceGroupMembersCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*api.APIClient)
+
+	err := applyGroupMembers(client, d.Get("group").(string), expandGroupMembers(d))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(d.Get("group").(string))
+
+	return resourceGroupMembersRead(ctx, d, m)
+}
+
+func resourceGroupMembersRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	client := m.(*api.APIClient)
+	group, err := client.GroupShow(d.Id(), JSON{
+		"all": true, // Otherwise we don't get the external members
+	})
+	if err != nil {
+		if err.(*api.APIError).Code == 4001 { // Group not found
+			d.SetId("")
+			return diags
+		}
+		return diag.FromErr(err)
+	}
+
+	if err := d.Set("group", d.Id()); err != nil {
+		return diag.FromErr(err)
+	}
+
+	current := groupDirectMembers(group)
+	for _, memberType := range groupMemberTypes {
+		configured := make([]string, 0)
+		for _, value := range d.Get(memberType.key).(*schema.Set).List() {
+			configured = append(configured, value.(string))
+		}
+		values := groupMembersAsConfigured(current[memberType.option], configured)
+		if err := d.Set(memberType.key, values); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return diags
+}
+
+func resourceGroupMembersUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*api.APIClient)
+
+	err := applyGroupMembers(client, d.Id(), expandGroupMembers(d))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceGroupMembersRead(ctx, d, m)
+}
+
+func resourceGroupMembersDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	client := m.(*api.APIClient)
+
+	// Remove all the members
+	err := applyGroupMembers(client, d.Id(), map[string][]string{
+		"user":              {},
+		"group":             {},
+		"service":           {},
+		"idoverrideuser":    {},
+		"ipaexternalmember": {},
+	})
+	if err != nil {
+		if apiErr, ok := err.(*api.APIError); ok && apiErr.Code == 4001 { // Group already deleted
+			return diags
+		}
+		return diag.FromErr(err)
+	}
+
+	return diags
+}
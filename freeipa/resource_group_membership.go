@@ -2,6 +2,10 @@ package freeipa
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"strings"
+
 	api "terraform-provider-freeipa/freeipa/api"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -56,12 +60,98 @@ func resourceGroupMembership() *schema.Resource {
 		ReadContext:   resourceGroupMembershipRead,
 		DeleteContext: resourceGroupMembershipDelete,
 		Schema:        schemaGroupMembership(),
+		SchemaVersion: 1,
+		StateUpgraders: []schema.StateUpgrader{
+			{
+				Version: 0,
+				Type:    resourceGroupMembershipV0().CoreConfigSchema().ImpliedType(),
+				Upgrade: resourceGroupMembershipStateUpgradeV0,
+			},
+		},
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: resourceGroupMembershipImport,
 		},
 	}
 }
 
+// The schema did not change in version 1, only the format of the ID
+func resourceGroupMembershipV0() *schema.Resource {
+	return &schema.Resource{
+		Schema: schemaGroupMembership(),
+	}
+}
+
+// Version 0 used group:member as ID, which was ambiguous and did not allow the import of manager memberships
+func resourceGroupMembershipStateUpgradeV0(ctx context.Context, rawState map[string]interface{}, m interface{}) (map[string]interface{}, error) {
+	group, _ := rawState["group"].(string)
+	member, _ := rawState["member"].(string)
+
+	// States imported with the passthrough importer only contain the ID
+	if group == "" || member == "" {
+		id, _ := rawState["id"].(string)
+		var found bool
+		group, member, found = strings.Cut(id, ":")
+		if !found || group == "" || member == "" {
+			return nil, fmt.Errorf("invalid legacy group membership ID %q, expected group:member", id)
+		}
+		rawState["group"] = group
+		rawState["member"] = member
+	}
+
+	memberType, _ := rawState["type"].(string)
+	if memberType == "" {
+		memberType = "user"
+		rawState["type"] = memberType
+	}
+	manager, _ := rawState["manager"].(bool)
+	rawState["manager"] = manager
+
+	rawState["id"] = formatGroupMembershipID(group, memberType, member, manager)
+
+	return rawState, nil
+}
+
+// The ID of a membership is in the form of group/type/member, with a /manager suffix for member managers
+// Service members contain a slash (service/host_fqdn), services cannot be managers so there is no ambiguity
+func formatGroupMembershipID(group string, memberType string, member string, manager bool) string {
+	id := group + "/" + memberType + "/" + member
+	if manager {
+		id += "/manager"
+	}
+
+	return id
+}
+
+func parseGroupMembershipID(id string) (string, string, string, bool, error) {
+	invalid := fmt.Errorf("invalid group membership ID %q, expected group/type/member or group/type/member/manager", id)
+
+	parts := strings.SplitN(id, "/", 3)
+	if len(parts) != 3 || parts[0] == "" || parts[1] == "" || parts[2] == "" {
+		return "", "", "", false, invalid
+	}
+	group, memberType, member := parts[0], parts[1], parts[2]
+
+	manager := false
+	switch memberType {
+	case "user", "group":
+		if strings.HasSuffix(member, "/manager") {
+			member = strings.TrimSuffix(member, "/manager")
+			manager = true
+		}
+		if member == "" || strings.Contains(member, "/") {
+			return "", "", "", false, invalid
+		}
+	case "service":
+		if !strings.Contains(member, "/") {
+			return "", "", "", false, invalid
+		}
+	default:
+		return "", "", "", false, fmt.Errorf(`invalid member type %q in group membership ID %q, must be one of "user", "group" or "service"`, memberType, id)
+	}
+
+	return group, memberType, member, manager, nil
+}
+
 func resourceGroupMembershipCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
 
@@ -88,41 +178,90 @@ func resourceGroupMembershipCreate(ctx context.Context, d *schema.ResourceData,
 		return diag.FromErr(err)
 	}
 
-	d.SetId(d.Get("group").(string) + ":" + d.Get("member").(string))
+	d.SetId(formatGroupMembershipID(
+		d.Get("group").(string),
+		d.Get("type").(string),
+		d.Get("member").(string),
+		d.Get("manager").(bool),
+	))
 
 	return diags
 }
 
-// Cannot implement this since the API only allow to retrieve user's groups
-func resourceGroupMembershipRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	var diags diag.Diagnostics
+// Services are returned with their realm (service/host_fqdn@REALM) but may be configured without it
+func groupMemberMatches(member string, value string) bool {
+	if strings.EqualFold(member, value) {
+		return true
+	}
 
-	if d.Get("type").(string) != "user" {
-		diags = append(diags, diag.Diagnostic{
-			Severity: diag.Warning,
-			Summary:  "Cannot check group membership",
-			Detail:   "The group membership cannot be checked for non-user members, you may need to manually check it",
-		})
-		return diags
+	if !strings.Contains(member, "@") {
+		principal, _, _ := strings.Cut(value, "@")
+		return strings.EqualFold(member, principal)
 	}
 
+	return false
+}
+
+func groupMembersContain(members []string, member string) bool {
+	for _, value := range members {
+		if groupMemberMatches(member, value) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func resourceGroupMembershipRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
 	client := m.(*api.APIClient)
-	groups, err := client.GetGroups(d.Get("member").(string), d.Get("type").(string))
+	group, err := client.GroupShow(d.Get("group").(string), nil)
 	if err != nil {
-		if err.(*api.APIError).Code == 4001 { // User, group or service not found
+		if err.(*api.APIError).Code == 4001 { // Group not found
 			d.SetId("")
 			return diags
 		}
 		return diag.FromErr(err)
 	}
 
-	for _, group_cn := range groups.CNs {
-		if group_cn == d.Get("group").(string) {
-			return nil
+	member := d.Get("member").(string)
+	memberType := d.Get("type").(string)
+
+	if d.Get("manager").(bool) {
+		managers := map[string][]string{
+			"user":  group.MemberManagerUser,
+			"group": group.MemberManagerGroup,
 		}
+		if !groupMembersContain(managers[memberType], member) {
+			d.SetId("")
+		}
+		return diags
+	}
+
+	direct := map[string][]string{
+		"user":    group.MemberUser,
+		"group":   group.MemberGroup,
+		"service": group.MemberService,
+	}
+	if groupMembersContain(direct[memberType], member) {
+		return diags
+	}
+
+	// The membership does not exist anymore, even if the member may still be in the group through a nested group
+	indirect := map[string][]string{
+		"user":    group.MemberIndirectUser,
+		"group":   group.MemberIndirectGroup,
+		"service": group.MemberIndirectService,
+	}
+	if groupMembersContain(indirect[memberType], member) {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Warning,
+			Summary:  "Indirect group membership",
+			Detail:   fmt.Sprintf("The %s %q is only an indirect member of the group %q (through a nested group), the direct membership will be created again", memberType, member, d.Get("group").(string)),
+		})
 	}
 
-	// If we reach this point, the group membership does not exist
 	d.SetId("")
 
 	return diags
@@ -147,3 +286,38 @@ func resourceGroupMembershipDelete(ctx context.Context, d *schema.ResourceData,
 
 	return diags
 }
+
+func resourceGroupMembershipImport(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	group, memberType, member, manager, err := parseGroupMembershipID(d.Id())
+	if err != nil {
+		return nil, err
+	}
+
+	attributes := JSON{
+		"group":   group,
+		"type":    memberType,
+		"member":  member,
+		"manager": manager,
+	}
+	for key, value := range attributes {
+		if err := d.Set(key, value); err != nil {
+			return nil, err
+		}
+	}
+	d.SetId(formatGroupMembershipID(group, memberType, member, manager))
+
+	// Make sure the membership exists
+	for _, diagnostic := range resourceGroupMembershipRead(ctx, d, m) {
+		if diagnostic.Severity == diag.Error {
+			return nil, errors.New(diagnostic.Summary)
+		}
+	}
+	if d.Id() == "" {
+		if manager {
+			return nil, fmt.Errorf("%s %q is not a manager of group %q", memberType, member, group)
+		}
+		return nil, fmt.Errorf("%s %q is not a direct member of group %q", memberType, member, group)
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
@@ -80,6 +80,10 @@ func schemaIdentityProvider() map[string]*schema.Schema {
 			Optional:         true,
 			ValidateDiagFunc: validation.ToDiagFunc(validation.StringIsNotWhiteSpace),
 		},
+		"extra_attributes": schemaExtraAttributes(
+			"cn", "ipaidpclientid", "ipaidpclientsecret", "ipaidpauthendpoint", "ipaidpdevauthendpoint", "ipaidptokenendpoint",
+			"ipaidpuserinfoendpoint", "ipaidpkeysendpoint", "ipaidpissuerurl", "ipaidpscope", "ipaidpsub",
+		),
 	}
 }
 
@@ -145,6 +149,7 @@ func resourceIdentityProviderCreate(ctx context.Context, d *schema.ResourceData,
 	if val, ok := d.GetOk("sub"); ok {
 		options["ipaidpsub"] = val.(string)
 	}
+	expandExtraAttributes(d, options)
 
 	idp, err := client.IdentityProviderAddGeneric(
 		d.Get("cn").(string),
@@ -187,6 +192,10 @@ func resourceIdentityProviderRead(ctx context.Context, d *schema.ResourceData, m
 		}
 	}
 
+	if err := readExtraAttributes(d, client, "idp_show"); err != nil {
+		return diag.FromErr(err)
+	}
+
 	return diags
 }
 
@@ -227,6 +236,9 @@ func resourceIdentityProviderUpdate(ctx context.Context, d *schema.ResourceData,
 	if d.HasChange("sub") {
 		options["ipaidpsub"] = d.Get("sub").(string)
 	}
+	if d.HasChange("extra_attributes") {
+		expandExtraAttributes(d, options)
+	}
 
 	_, err := client.IdentityProviderMod(d.Id(), options)
 	if err != nil {
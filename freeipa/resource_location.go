@@ -0,0 +1,129 @@
+package freeipa
+
+import (
+	"context"
+
+	api "terraform-provider-freeipa/freeipa/api"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func schemaLocation() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"idnsname": {
+			Description: "Location name",
+			Type:        schema.TypeString,
+			ForceNew:    true,
+			Required:    true,
+			ValidateDiagFunc: validation.ToDiagFunc(validation.All(
+				validation.StringIsNotWhiteSpace,
+				StringContainsNoUpperLetter,
+			)),
+		},
+		"description": {
+			Description: "Location description",
+			Type:        schema.TypeString,
+			Optional:    true,
+		},
+	}
+}
+
+func resourceLocation() *schema.Resource {
+	return &schema.Resource{
+		Description:   "Manage FreeIPA server locations (DNS locations)",
+		CreateContext: resourceLocationCreate,
+		ReadContext:   resourceLocationRead,
+		UpdateContext: resourceLocationUpdate,
+		DeleteContext: resourceLocationDelete,
+		Schema:        schemaLocation(),
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+func flattenLocation(location *api.Location) JSON {
+	flat := JSON{
+		"idnsname": location.Name[0].String(),
+	}
+
+	if len(location.Description) > 0 {
+		flat["description"] = location.Description[0]
+	} else {
+		flat["description"] = ""
+	}
+
+	return flat
+}
+
+func resourceLocationCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*api.APIClient)
+
+	options := JSON{}
+	if val, ok := d.GetOk("description"); ok {
+		options["description"] = val.(string)
+	}
+
+	location, err := client.LocationAdd(d.Get("idnsname").(string), options)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(location.Name[0].String())
+
+	return resourceLocationRead(ctx, d, m)
+}
+
+func resourceLocationRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	client := m.(*api.APIClient)
+	location, err := client.LocationShow(d.Id(), nil)
+	if err != nil {
+		if err.(*api.APIError).Code == 4001 { // Location not found
+			d.SetId("")
+			return diags
+		}
+		return diag.FromErr(err)
+	}
+
+	for key, value := range flattenLocation(location) {
+		if err := d.Set(key, value); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return diags
+}
+
+func resourceLocationUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*api.APIClient)
+
+	options := JSON{}
+	if d.HasChange("description") {
+		options["description"] = d.Get("description").(string)
+	}
+
+	if d.HasChangeExcept("idnsname") {
+		_, err := client.LocationMod(d.Id(), options)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return resourceLocationRead(ctx, d, m)
+}
+
+func resourceLocationDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	client := m.(*api.APIClient)
+	_, err := client.LocationDel(d.Id(), nil)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diags
+}
@@ -0,0 +1,106 @@
+package freeipa
+
+import (
+	"context"
+
+	api "terraform-provider-freeipa/freeipa/api"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// The passkey configuration is a singleton, there is only one per realm
+const passkeyConfigID = "passkeyconfig"
+
+func schemaPasskeyConfig() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"require_user_verification": {
+			Description: "Require user verification (PIN, biometrics...) during passkey authentication",
+			Type:        schema.TypeBool,
+			Required:    true,
+		},
+	}
+}
+
+func resourcePasskeyConfig() *schema.Resource {
+	return &schema.Resource{
+		Description:   "Manage the FreeIPA passkey configuration\nThere is only one passkey configuration, destroying this resource leaves it untouched.",
+		CreateContext: resourcePasskeyConfigCreate,
+		ReadContext:   resourcePasskeyConfigRead,
+		UpdateContext: resourcePasskeyConfigUpdate,
+		DeleteContext: resourcePasskeyConfigDelete,
+		Schema:        schemaPasskeyConfig(),
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+func flattenPasskeyConfig(config *api.PasskeyConfig) JSON {
+	flat := JSON{
+		"require_user_verification": false,
+	}
+
+	if len(config.RequireUserVerification) > 0 {
+		flat["require_user_verification"] = config.RequireUserVerification[0].Bool()
+	}
+
+	return flat
+}
+
+func resourcePasskeyConfigCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*api.APIClient)
+
+	_, err := client.PasskeyConfigMod(JSON{
+		"iparequireuserverification": d.Get("require_user_verification").(bool),
+	})
+	if err != nil {
+		if apiErr, ok := err.(*api.APIError); !ok || apiErr.Code != 4202 { // Configuration already up to date
+			return diag.FromErr(err)
+		}
+	}
+
+	d.SetId(passkeyConfigID)
+
+	return resourcePasskeyConfigRead(ctx, d, m)
+}
+
+func resourcePasskeyConfigRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	client := m.(*api.APIClient)
+	config, err := client.PasskeyConfigShow(nil)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	for key, value := range flattenPasskeyConfig(config) {
+		if err := d.Set(key, value); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return diags
+}
+
+func resourcePasskeyConfigUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*api.APIClient)
+
+	if d.HasChange("require_user_verification") {
+		_, err := client.PasskeyConfigMod(JSON{
+			"iparequireuserverification": d.Get("require_user_verification").(bool),
+		})
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return resourcePasskeyConfigRead(ctx, d, m)
+}
+
+// The passkey configuration cannot be deleted, only forget about it
+func resourcePasskeyConfigDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	return diags
+}
@@ -0,0 +1,143 @@
+package freeipa
+
+import (
+	"context"
+
+	api "terraform-provider-freeipa/freeipa/api"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// The realm domains are a singleton, there is only one list per realm
+const realmDomainsID = "realmdomains"
+
+func schemaRealmDomains() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"domains": {
+			Description: "Domains associated with the realm\nThis list is authoritative and must include the IPA domain itself.",
+			Type:        schema.TypeSet,
+			Required:    true,
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
+				ValidateDiagFunc: validation.ToDiagFunc(validation.All(
+					validation.StringIsNotWhiteSpace,
+					StringContainsNoUpperLetter,
+				)),
+			},
+		},
+		"force": {
+			Description: "Add domains even if they have no matching DNS records",
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Default:     false,
+		},
+	}
+}
+
+func resourceRealmDomains() *schema.Resource {
+	return &schema.Resource{
+		Description:   "Manage the complete list of domains associated with the FreeIPA realm\nDomains added outside of Terraform (e.g. by other tooling) are removed on the next apply. There is only one list of realm domains, destroying this resource leaves the domains untouched.",
+		CreateContext: resourceRealmDomainsCreate,
+		ReadContext:   resourceRealmDomainsRead,
+		UpdateContext: resourceRealmDomainsUpdate,
+		DeleteContext: resourceRealmDomainsDelete,
+		Schema:        schemaRealmDomains(),
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+// Add and remove domains one by one until the server matches the configuration, the configuration is authoritative
+func applyRealmDomains(client *api.APIClient, d *schema.ResourceData) error {
+	current, err := client.RealmDomainsShow(nil)
+	if err != nil {
+		return err
+	}
+
+	existing := make(map[string]bool)
+	for _, domain := range current.AssociatedDomain {
+		existing[domain] = true
+	}
+
+	wanted := make(map[string]bool)
+	for _, domain := range d.Get("domains").(*schema.Set).List() {
+		wanted[domain.(string)] = true
+	}
+
+	for domain := range wanted {
+		if existing[domain] {
+			continue
+		}
+		_, err := client.RealmDomainsMod(JSON{
+			"add_domain": domain,
+			"force":      d.Get("force").(bool),
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	for domain := range existing {
+		if wanted[domain] {
+			continue
+		}
+		_, err := client.RealmDomainsMod(JSON{
+			"del_domain": domain,
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func resourceRealmDomainsCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*api.APIClient)
+
+	if err := applyRealmDomains(client, d); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(realmDomainsID)
+
+	return resourceRealmDomainsRead(ctx, d, m)
+}
+
+func resourceRealmDomainsRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	client := m.(*api.APIClient)
+	realmDomains, err := client.RealmDomainsShow(nil)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := d.Set("domains", realmDomains.AssociatedDomain); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diags
+}
+
+func resourceRealmDomainsUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*api.APIClient)
+
+	if d.HasChange("domains") {
+		if err := applyRealmDomains(client, d); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return resourceRealmDomainsRead(ctx, d, m)
+}
+
+// The realm domains cannot be deleted, only forget about them
+func resourceRealmDomainsDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	return diags
+}
@@ -0,0 +1,159 @@
+package freeipa
+
+import (
+	"context"
+
+	api "terraform-provider-freeipa/freeipa/api"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func schemaServerLocation() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"server": {
+			Description:      "Server name (FQDN)",
+			Type:             schema.TypeString,
+			ForceNew:         true,
+			Required:         true,
+			ValidateDiagFunc: validation.ToDiagFunc(validation.StringIsNotWhiteSpace),
+		},
+		"location": {
+			Description:      "Location name",
+			Type:             schema.TypeString,
+			Required:         true,
+			ValidateDiagFunc: validation.ToDiagFunc(validation.StringIsNotWhiteSpace),
+		},
+		"serviceweight": {
+			Description:      "Weight of the server's services in the location\nIf not specified, the default weight (100) will be used.",
+			Type:             schema.TypeInt,
+			Optional:         true,
+			Computed:         true,
+			ValidateDiagFunc: validation.ToDiagFunc(validation.IntBetween(0, 65535)),
+		},
+	}
+}
+
+func resourceServerLocation() *schema.Resource {
+	return &schema.Resource{
+		Description:   "Manage the location a FreeIPA server belongs to",
+		CreateContext: resourceServerLocationCreate,
+		ReadContext:   resourceServerLocationRead,
+		UpdateContext: resourceServerLocationUpdate,
+		DeleteContext: resourceServerLocationDelete,
+		Schema:        schemaServerLocation(),
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+func flattenServerLocation(server *api.Server) JSON {
+	flat := JSON{
+		"server": server.CN[0],
+	}
+
+	if len(server.Location) > 0 {
+		flat["location"] = server.Location[0].String()
+	} else {
+		flat["location"] = ""
+	}
+
+	if len(server.ServiceWeight) > 0 {
+		weight, _ := server.ServiceWeight[0].Int64()
+		flat["serviceweight"] = int(weight)
+	} else {
+		flat["serviceweight"] = 0
+	}
+
+	return flat
+}
+
+func resourceServerLocationCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*api.APIClient)
+
+	options := JSON{
+		"ipalocation_location": d.Get("location").(string),
+	}
+	if val, ok := d.GetOk("serviceweight"); ok {
+		options["ipaserviceweight"] = val.(int)
+	}
+
+	_, err := client.ServerMod(d.Get("server").(string), options)
+	if err != nil {
+		if apiErr, ok := err.(*api.APIError); !ok || apiErr.Code != 4202 { // Server already in this location
+			return diag.FromErr(err)
+		}
+	}
+
+	d.SetId(d.Get("server").(string))
+
+	return resourceServerLocationRead(ctx, d, m)
+}
+
+func resourceServerLocationRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	client := m.(*api.APIClient)
+	server, err := client.ServerShow(d.Id(), nil)
+	if err != nil {
+		if err.(*api.APIError).Code == 4001 { // Server not found
+			d.SetId("")
+			return diags
+		}
+		return diag.FromErr(err)
+	}
+
+	// The server is not assigned to any location anymore
+	if len(server.Location) == 0 {
+		d.SetId("")
+		return diags
+	}
+
+	for key, value := range flattenServerLocation(server) {
+		if err := d.Set(key, value); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return diags
+}
+
+func resourceServerLocationUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*api.APIClient)
+
+	options := JSON{}
+	if d.HasChange("location") {
+		options["ipalocation_location"] = d.Get("location").(string)
+	}
+	if d.HasChange("serviceweight") {
+		options["ipaserviceweight"] = d.Get("serviceweight").(int)
+	}
+
+	if d.HasChangeExcept("server") {
+		_, err := client.ServerMod(d.Id(), options)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return resourceServerLocationRead(ctx, d, m)
+}
+
+func resourceServerLocationDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	client := m.(*api.APIClient)
+	_, err := client.ServerMod(d.Id(), JSON{
+		"ipalocation_location": "",
+		"ipaserviceweight":     "",
+	})
+	if err != nil {
+		if apiErr, ok := err.(*api.APIError); !ok || apiErr.Code != 4202 { // Server not in any location
+			return diag.FromErr(err)
+		}
+	}
+
+	return diags
+}
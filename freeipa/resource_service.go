@@ -2,6 +2,8 @@ package freeipa
 
 import (
 	"context"
+	"fmt"
+	"sort"
 	"strings"
 
 	api "terraform-provider-freeipa/freeipa/api"
@@ -9,10 +11,34 @@ import (
 	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
+// Keytab permissions of a service, managed with dedicated allow and disallow API methods
+type serviceKeytabPermission struct {
+	key         string // Prefix of the attribute names in the schema
+	description string
+	allow       func(*api.APIClient, string, JSON) (*api.Service, error)
+	disallow    func(*api.APIClient, string, JSON) (*api.Service, error)
+}
+
+var serviceKeytabPermissions = []serviceKeytabPermission{
+	{
+		key:         "read_keys",
+		description: "Principals allowed to retrieve the keytab",
+		allow:       (*api.APIClient).ServiceAllowRetrieveKeytab,
+		disallow:    (*api.APIClient).ServiceDisallowRetrieveKeytab,
+	},
+	{
+		key:         "write_keys",
+		description: "Principals allowed to create the keytab",
+		allow:       (*api.APIClient).ServiceAllowCreateKeytab,
+		disallow:    (*api.APIClient).ServiceDisallowCreateKeytab,
+	},
+}
+
 func schemaService() map[string]*schema.Schema {
-	return map[string]*schema.Schema{
+	service := map[string]*schema.Schema{
 		"krbcanonicalname": {
 			Description: "Service canonical name (in the form of service/host_fqdn)",
 			Type:        schema.TypeString,
@@ -33,7 +59,25 @@ func schemaService() map[string]*schema.Schema {
 				return diags
 			},
 		},
+		"extra_attributes": schemaExtraAttributes("krbcanonicalname", "krbprincipalname", "ipaallowedtoperform;read_keys", "ipaallowedtoperform;write_keys"),
+	}
+
+	// Principals allowed to retrieve (read_keys) or create (write_keys) the keytab
+	for _, permission := range serviceKeytabPermissions {
+		for _, principal := range []string{"user", "group", "host", "hostgroup"} {
+			service[permission.key+"_"+principal] = &schema.Schema{
+				Description: permission.description + " (" + principal + " names)",
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Elem: &schema.Schema{
+					Type:             schema.TypeString,
+					ValidateDiagFunc: validation.ToDiagFunc(validation.StringIsNotWhiteSpace),
+				},
+			}
+		}
 	}
+
+	return service
 }
 
 func resourceService() *schema.Resource {
@@ -41,7 +85,7 @@ func resourceService() *schema.Resource {
 		Description:   "Manage FreeIPA services",
 		CreateContext: resourceServiceCreate,
 		ReadContext:   resourceServiceRead,
-		// UpdateContext: resourceServiceUpdate,
+		UpdateContext: resourceServiceUpdate,
 		DeleteContext: resourceServiceDelete,
 		Schema:        schemaService(),
 		Importer: &schema.ResourceImporter{
@@ -55,21 +99,115 @@ func flattenService(service *api.Service) JSON {
 		"krbcanonicalname": service.KrbCanonicalName[0],
 	}
 
+	principals := map[string][]string{
+		"read_keys_user":       service.ReadKeysUser,
+		"read_keys_group":      service.ReadKeysGroup,
+		"read_keys_host":       service.ReadKeysHost,
+		"read_keys_hostgroup":  service.ReadKeysHostGroup,
+		"write_keys_user":      service.WriteKeysUser,
+		"write_keys_group":     service.WriteKeysGroup,
+		"write_keys_host":      service.WriteKeysHost,
+		"write_keys_hostgroup": service.WriteKeysHostGroup,
+	}
+	for key, value := range principals {
+		if len(value) > 0 {
+			flat[key] = value
+		} else {
+			flat[key] = make([]string, 0)
+		}
+	}
+
 	return flat
 }
 
-func resourceServiceCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	var diags diag.Diagnostics
+// Disallow the principals that are not wanted anymore, then allow the new ones
+// The API does not report principals that could not be allowed or disallowed as errors, so the result is checked afterwards
+func updateServiceKeytabPermissions(client *api.APIClient, d *schema.ResourceData) error {
+	changed := make([]string, 0)
+	for _, permission := range serviceKeytabPermissions {
+		allowed := JSON{}
+		disallowed := JSON{}
+
+		for _, principal := range []string{"user", "group", "host", "hostgroup"} {
+			key := permission.key + "_" + principal
+			if !d.HasChange(key) {
+				continue
+			}
+			changed = append(changed, key)
+
+			oldValues, newValues := d.GetChange(key)
+			if removed := oldValues.(*schema.Set).Difference(newValues.(*schema.Set)); removed.Len() > 0 {
+				disallowed[principal] = removed.List()
+			}
+			if added := newValues.(*schema.Set).Difference(oldValues.(*schema.Set)); added.Len() > 0 {
+				allowed[principal] = added.List()
+			}
+		}
+
+		if len(disallowed) > 0 {
+			_, err := permission.disallow(client, d.Id(), disallowed)
+			if err != nil {
+				return err
+			}
+		}
+		if len(allowed) > 0 {
+			_, err := permission.allow(client, d.Id(), allowed)
+			if err != nil {
+				return err
+			}
+		}
+	}
+	if len(changed) == 0 {
+		return nil
+	}
+
+	service, err := client.ServiceShow(d.Id(), nil)
+	if err != nil {
+		return err
+	}
+	current := flattenService(service)
 
+	problems := make([]string, 0)
+	for _, key := range changed {
+		wanted := make([]string, 0)
+		for _, value := range d.Get(key).(*schema.Set).List() {
+			wanted = append(wanted, value.(string))
+		}
+		values := current[key].([]string)
+
+		for _, value := range groupMembersDifference(wanted, values) {
+			problems = append(problems, fmt.Sprintf("%s %q could not be allowed", key, value))
+		}
+		for _, value := range groupMembersDifference(values, wanted) {
+			problems = append(problems, fmt.Sprintf("%s %q could not be disallowed", key, value))
+		}
+	}
+	if len(problems) > 0 {
+		sort.Strings(problems)
+		return fmt.Errorf("keytab permissions of service %q are not in line with the configuration: %s", d.Id(), strings.Join(problems, ", "))
+	}
+
+	return nil
+}
+
+func resourceServiceCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	client := m.(*api.APIClient)
-	service, err := client.ServiceAdd(d.Get("krbcanonicalname").(string), nil)
+
+	options := JSON{}
+	expandExtraAttributes(d, options)
+
+	service, err := client.ServiceAdd(d.Get("krbcanonicalname").(string), options)
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
 	d.SetId(service.KrbCanonicalName[0])
 
-	return diags
+	if err := updateServiceKeytabPermissions(client, d); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceServiceRead(ctx, d, m)
 }
 
 func resourceServiceRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
@@ -91,24 +229,33 @@ func resourceServiceRead(ctx context.Context, d *schema.ResourceData, m interfac
 		}
 	}
 
+	if err := readExtraAttributes(d, client, "service_show"); err != nil {
+		return diag.FromErr(err)
+	}
+
 	return diags
 }
 
-//lint:ignore U1000 Function is unused at the moment, but it's here for potential future use
 func resourceServiceUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-
 	client := m.(*api.APIClient)
 
-	options := JSON{}
+	if d.HasChange("extra_attributes") {
+		options := JSON{}
+		expandExtraAttributes(d, options)
 
-	if d.HasChange("krbcanonicalname") {
 		_, err := client.ServiceMod(d.Id(), options)
 		if err != nil {
-			return diag.FromErr(err)
+			if apiErr, ok := err.(*api.APIError); !ok || apiErr.Code != 4202 { // Attributes already up to date
+				return diag.FromErr(err)
+			}
 		}
 	}
 
-	return resourceGroupRead(ctx, d, m)
+	if err := updateServiceKeytabPermissions(client, d); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceServiceRead(ctx, d, m)
 }
 
 func resourceServiceDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
@@ -0,0 +1,151 @@
+package freeipa
+
+import (
+	"context"
+
+	api "terraform-provider-freeipa/freeipa/api"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func schemaServiceDelegationRule() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"cn": {
+			Description:      "Delegation rule name",
+			Type:             schema.TypeString,
+			ForceNew:         true,
+			Required:         true,
+			ValidateDiagFunc: validation.ToDiagFunc(validation.StringIsNotWhiteSpace),
+		},
+		"members": {
+			Description: "Principals allowed to delegate (in the form of service/host_fqdn@REALM)",
+			Type:        schema.TypeSet,
+			Optional:    true,
+			Elem: &schema.Schema{
+				Type:             schema.TypeString,
+				ValidateDiagFunc: validation.ToDiagFunc(validation.StringIsNotWhiteSpace),
+			},
+		},
+		"targets": {
+			Description: "Delegation targets the members can delegate to",
+			Type:        schema.TypeSet,
+			Optional:    true,
+			Elem: &schema.Schema{
+				Type:             schema.TypeString,
+				ValidateDiagFunc: validation.ToDiagFunc(validation.StringIsNotWhiteSpace),
+			},
+		},
+	}
+}
+
+func resourceServiceDelegationRule() *schema.Resource {
+	return &schema.Resource{
+		Description:   "Manage FreeIPA service delegation rules (constrained delegation)",
+		CreateContext: resourceServiceDelegationRuleCreate,
+		ReadContext:   resourceServiceDelegationRuleRead,
+		UpdateContext: resourceServiceDelegationRuleUpdate,
+		DeleteContext: resourceServiceDelegationRuleDelete,
+		Schema:        schemaServiceDelegationRule(),
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+func flattenServiceDelegationRule(rule *api.ServiceDelegationRule) JSON {
+	flat := JSON{
+		"cn":      rule.CN[0],
+		"members": make([]string, 0),
+		"targets": make([]string, 0),
+	}
+
+	if len(rule.MemberPrincipal) > 0 {
+		flat["members"] = rule.MemberPrincipal
+	}
+	if len(rule.Targets) > 0 {
+		flat["targets"] = rule.Targets
+	}
+
+	return flat
+}
+
+func updateServiceDelegationRule(client *api.APIClient, d *schema.ResourceData) error {
+	if d.HasChange("members") {
+		err := updateSetAttribute(d, "members", "principal", client.ServiceDelegationRuleAddMember, client.ServiceDelegationRuleRemoveMember)
+		if err != nil {
+			return err
+		}
+	}
+
+	if d.HasChange("targets") {
+		err := updateSetAttribute(d, "targets", "servicedelegationtarget", client.ServiceDelegationRuleAddTarget, client.ServiceDelegationRuleRemoveTarget)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func resourceServiceDelegationRuleCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*api.APIClient)
+
+	rule, err := client.ServiceDelegationRuleAdd(d.Get("cn").(string), nil)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(rule.CN[0])
+
+	if err := updateServiceDelegationRule(client, d); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceServiceDelegationRuleRead(ctx, d, m)
+}
+
+func resourceServiceDelegationRuleRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	client := m.(*api.APIClient)
+	rule, err := client.ServiceDelegationRuleShow(d.Id(), nil)
+	if err != nil {
+		if err.(*api.APIError).Code == 4001 { // Rule not found
+			d.SetId("")
+			return diags
+		}
+		return diag.FromErr(err)
+	}
+
+	for key, value := range flattenServiceDelegationRule(rule) {
+		if err := d.Set(key, value); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return diags
+}
+
+func resourceServiceDelegationRuleUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*api.APIClient)
+
+	if err := updateServiceDelegationRule(client, d); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceServiceDelegationRuleRead(ctx, d, m)
+}
+
+func resourceServiceDelegationRuleDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	client := m.(*api.APIClient)
+	_, err := client.ServiceDelegationRuleDel(d.Id(), nil)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diags
+}
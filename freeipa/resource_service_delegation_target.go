@@ -0,0 +1,124 @@
+package freeipa
+
+import (
+	"context"
+
+	api "terraform-provider-freeipa/freeipa/api"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func schemaServiceDelegationTarget() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"cn": {
+			Description:      "Delegation target name",
+			Type:             schema.TypeString,
+			ForceNew:         true,
+			Required:         true,
+			ValidateDiagFunc: validation.ToDiagFunc(validation.StringIsNotWhiteSpace),
+		},
+		"members": {
+			Description: "Principals that can be delegated to (in the form of service/host_fqdn@REALM)",
+			Type:        schema.TypeSet,
+			Optional:    true,
+			Elem: &schema.Schema{
+				Type:             schema.TypeString,
+				ValidateDiagFunc: validation.ToDiagFunc(validation.StringIsNotWhiteSpace),
+			},
+		},
+	}
+}
+
+func resourceServiceDelegationTarget() *schema.Resource {
+	return &schema.Resource{
+		Description:   "Manage FreeIPA service delegation targets (constrained delegation)",
+		CreateContext: resourceServiceDelegationTargetCreate,
+		ReadContext:   resourceServiceDelegationTargetRead,
+		UpdateContext: resourceServiceDelegationTargetUpdate,
+		DeleteContext: resourceServiceDelegationTargetDelete,
+		Schema:        schemaServiceDelegationTarget(),
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+func flattenServiceDelegationTarget(target *api.ServiceDelegationTarget) JSON {
+	flat := JSON{
+		"cn":      target.CN[0],
+		"members": make([]string, 0),
+	}
+
+	if len(target.MemberPrincipal) > 0 {
+		flat["members"] = target.MemberPrincipal
+	}
+
+	return flat
+}
+
+func resourceServiceDelegationTargetCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*api.APIClient)
+
+	target, err := client.ServiceDelegationTargetAdd(d.Get("cn").(string), nil)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(target.CN[0])
+
+	err = updateSetAttribute(d, "members", "principal", client.ServiceDelegationTargetAddMember, client.ServiceDelegationTargetRemoveMember)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceServiceDelegationTargetRead(ctx, d, m)
+}
+
+func resourceServiceDelegationTargetRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	client := m.(*api.APIClient)
+	target, err := client.ServiceDelegationTargetShow(d.Id(), nil)
+	if err != nil {
+		if err.(*api.APIError).Code == 4001 { // Target not found
+			d.SetId("")
+			return diags
+		}
+		return diag.FromErr(err)
+	}
+
+	for key, value := range flattenServiceDelegationTarget(target) {
+		if err := d.Set(key, value); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return diags
+}
+
+func resourceServiceDelegationTargetUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*api.APIClient)
+
+	if d.HasChange("members") {
+		err := updateSetAttribute(d, "members", "principal", client.ServiceDelegationTargetAddMember, client.ServiceDelegationTargetRemoveMember)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return resourceServiceDelegationTargetRead(ctx, d, m)
+}
+
+func resourceServiceDelegationTargetDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	client := m.(*api.APIClient)
+	_, err := client.ServiceDelegationTargetDel(d.Id(), nil)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diags
+}
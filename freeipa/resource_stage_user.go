@@ -0,0 +1,168 @@
+package freeipa
+
+import (
+	"context"
+
+	api "terraform-provider-freeipa/freeipa/api"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func schemaStageUser() map[string]*schema.Schema {
+	stageUser := schemaUser()
+
+	stageUser["activate"] = &schema.Schema{
+		Description: "Activate the stage user, turning it into an active user\nOnce activated, the user is left untouched by this resource and should be imported in a freeipa_user resource.",
+		Type:        schema.TypeBool,
+		Optional:    true,
+		Default:     false,
+	}
+
+	return stageUser
+}
+
+func resourceStageUser() *schema.Resource {
+	return &schema.Resource{
+		Description:   "Manage FreeIPA stage users",
+		CreateContext: resourceStageUserCreate,
+		ReadContext:   resourceStageUserRead,
+		UpdateContext: resourceStageUserUpdate,
+		DeleteContext: resourceStageUserDelete,
+		Schema:        schemaStageUser(),
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+func resourceStageUserCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*api.APIClient)
+
+	if err := validateUserIdP(d, client); err != nil {
+		return diag.FromErr(err)
+	}
+
+	user, err := client.StageUserAdd(d.Get("uid").(string),
+		d.Get("givenname").(string),
+		d.Get("sn").(string),
+		expandUserCreateOptions(d),
+	)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(user.UID[0])
+
+	if err := setRandomPassword(d, user); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if d.Get("activate").(bool) {
+		_, err := client.StageUserActivate(d.Id(), nil)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return resourceStageUserRead(ctx, d, m)
+}
+
+func resourceStageUserRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	client := m.(*api.APIClient)
+	user, err := client.StageUserShow(d.Id(), JSON{
+		"all": true, // Retrieves all attributes, this is MANDATORY
+	})
+	if err != nil {
+		if err.(*api.APIError).Code != 4001 { // Stage user not found
+			return diag.FromErr(err)
+		}
+
+		// An activated stage user is now an active user, keep the state as is
+		if d.Get("activate").(bool) {
+			_, err := client.UserShow(d.Id(), nil)
+			if err == nil {
+				return diags
+			}
+			if err.(*api.APIError).Code != 4001 { // User not found
+				return diag.FromErr(err)
+			}
+		}
+
+		d.SetId("")
+		return diags
+	}
+
+	for key, value := range flattenUser(user) {
+		if err := d.Set(key, value); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	// The stage user still exists, so it is not activated
+	if err := d.Set("activate", false); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diags
+}
+
+func resourceStageUserUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	client := m.(*api.APIClient)
+
+	activated, _ := d.GetChange("activate")
+	if activated.(bool) {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Stage user already activated",
+			Detail:   "The stage user has been activated, it must now be managed with a freeipa_user resource",
+		})
+		return diags
+	}
+
+	if err := validateUserIdP(d, client); err != nil {
+		return diag.FromErr(err)
+	}
+
+	options := expandUserUpdateOptions(d)
+	if len(options) > 0 {
+		user, err := client.StageUserMod(d.Id(), options)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		if err := setRandomPassword(d, user); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	if d.Get("activate").(bool) {
+		_, err := client.StageUserActivate(d.Id(), nil)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return resourceStageUserRead(ctx, d, m)
+}
+
+func resourceStageUserDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	// The user has been handed off to a freeipa_user resource
+	if d.Get("activate").(bool) {
+		return diags
+	}
+
+	client := m.(*api.APIClient)
+	_, err := client.StageUserDel(d.Id(), nil)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diags
+}
@@ -0,0 +1,163 @@
+package freeipa
+
+import (
+	"context"
+	"encoding/json"
+
+	api "terraform-provider-freeipa/freeipa/api"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func schemaSubID() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"owner": {
+			Description:      "Owner of the subordinate IDs (user UID)",
+			Type:             schema.TypeString,
+			ForceNew:         true,
+			Required:         true,
+			ValidateDiagFunc: validation.ToDiagFunc(validation.StringIsNotWhiteSpace),
+		},
+		"description": {
+			Description: "Subordinate ID description",
+			Type:        schema.TypeString,
+			Optional:    true,
+		},
+		"subuidnumber": {
+			Description: "Start value of the subordinate user ID range",
+			Type:        schema.TypeInt,
+			Computed:    true,
+		},
+		"subuidcount": {
+			Description: "Size of the subordinate user ID range",
+			Type:        schema.TypeInt,
+			Computed:    true,
+		},
+		"subgidnumber": {
+			Description: "Start value of the subordinate group ID range",
+			Type:        schema.TypeInt,
+			Computed:    true,
+		},
+		"subgidcount": {
+			Description: "Size of the subordinate group ID range",
+			Type:        schema.TypeInt,
+			Computed:    true,
+		},
+	}
+}
+
+func resourceSubID() *schema.Resource {
+	return &schema.Resource{
+		Description:   "Manage FreeIPA subordinate IDs (used for rootless containers)",
+		CreateContext: resourceSubIDCreate,
+		ReadContext:   resourceSubIDRead,
+		UpdateContext: resourceSubIDUpdate,
+		DeleteContext: resourceSubIDDelete,
+		Schema:        schemaSubID(),
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+func flattenSubID(subid *api.SubID) JSON {
+	flat := JSON{
+		"owner":        subid.Owner[0],
+		"description":  "",
+		"subuidnumber": 0,
+		"subuidcount":  0,
+		"subgidnumber": 0,
+		"subgidcount":  0,
+	}
+
+	if len(subid.Description) > 0 {
+		flat["description"] = subid.Description[0]
+	}
+
+	numbers := map[string][]json.Number{
+		"subuidnumber": subid.SubUIDNumber,
+		"subuidcount":  subid.SubUIDCount,
+		"subgidnumber": subid.SubGIDNumber,
+		"subgidcount":  subid.SubGIDCount,
+	}
+	for key, value := range numbers {
+		if len(value) > 0 {
+			number, _ := value[0].Int64()
+			flat[key] = int(number)
+		}
+	}
+
+	return flat
+}
+
+func resourceSubIDCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*api.APIClient)
+
+	options := JSON{}
+	if val, ok := d.GetOk("description"); ok {
+		options["description"] = val.(string)
+	}
+
+	subid, err := client.SubIDGenerate(d.Get("owner").(string), options)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(subid.UniqueID[0])
+
+	return resourceSubIDRead(ctx, d, m)
+}
+
+func resourceSubIDRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	client := m.(*api.APIClient)
+	subid, err := client.SubIDShow(d.Id(), nil)
+	if err != nil {
+		if err.(*api.APIError).Code == 4001 { // Subordinate ID not found
+			d.SetId("")
+			return diags
+		}
+		return diag.FromErr(err)
+	}
+
+	for key, value := range flattenSubID(subid) {
+		if err := d.Set(key, value); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return diags
+}
+
+func resourceSubIDUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*api.APIClient)
+
+	options := JSON{}
+	if d.HasChange("description") {
+		options["description"] = d.Get("description").(string)
+	}
+
+	if d.HasChangeExcept("owner") {
+		_, err := client.SubIDMod(d.Id(), options)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return resourceSubIDRead(ctx, d, m)
+}
+
+func resourceSubIDDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	client := m.(*api.APIClient)
+	_, err := client.SubIDDel(d.Id(), nil)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diags
+}
@@ -0,0 +1,271 @@
+package freeipa
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	api "terraform-provider-freeipa/freeipa/api"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func schemaTopologySegment() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"suffix": {
+			Description:      `Topology suffix (must be one of "domain" or "ca")`,
+			Type:             schema.TypeString,
+			ForceNew:         true,
+			Required:         true,
+			ValidateDiagFunc: validation.ToDiagFunc(validation.StringInSlice([]string{"domain", "ca"}, false)),
+		},
+		"cn": {
+			Description:      "Segment name",
+			Type:             schema.TypeString,
+			ForceNew:         true,
+			Required:         true,
+			ValidateDiagFunc: validation.ToDiagFunc(validation.StringIsNotWhiteSpace),
+		},
+		"leftnode": {
+			Description:      "Left replication node (server FQDN)",
+			Type:             schema.TypeString,
+			ForceNew:         true,
+			Required:         true,
+			ValidateDiagFunc: validation.ToDiagFunc(validation.StringIsNotWhiteSpace),
+		},
+		"rightnode": {
+			Description:      "Right replication node (server FQDN)",
+			Type:             schema.TypeString,
+			ForceNew:         true,
+			Required:         true,
+			ValidateDiagFunc: validation.ToDiagFunc(validation.StringIsNotWhiteSpace),
+		},
+		"direction": {
+			Description:      `Direction of replication between the nodes (must be one of "both", "left-right" or "right-left")`,
+			Type:             schema.TypeString,
+			ForceNew:         true,
+			Optional:         true,
+			Default:          "both",
+			ValidateDiagFunc: validation.ToDiagFunc(validation.StringInSlice([]string{"both", "left-right", "right-left"}, false)),
+		},
+		"replicatedattributelist": {
+			Description: "Attributes excluded from incremental updates (in the form of (objectclass=*) $ EXCLUDE attribute...)\nIf not specified, the default list will be used.",
+			Type:        schema.TypeString,
+			Optional:    true,
+			Computed:    true,
+		},
+		"replicatedattributelisttotal": {
+			Description: "Attributes excluded from total updates (in the form of (objectclass=*) $ EXCLUDE attribute...)\nIf not specified, the default list will be used.",
+			Type:        schema.TypeString,
+			Optional:    true,
+			Computed:    true,
+		},
+		"replicastripattrs": {
+			Description: "Space separated list of attributes removed from replication updates\nIf not specified, the default list will be used.",
+			Type:        schema.TypeString,
+			Optional:    true,
+			Computed:    true,
+		},
+		"replicatimeout": {
+			Description:      "Number of seconds outbound LDAP operations wait for a response from the remote replica\nIf not specified, the default timeout will be used.",
+			Type:             schema.TypeInt,
+			Optional:         true,
+			Computed:         true,
+			ValidateDiagFunc: validation.ToDiagFunc(validation.IntAtLeast(0)),
+		},
+		"replicaenabled": {
+			Description: "Whether the replication agreement is enabled",
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Default:     true,
+		},
+	}
+}
+
+func resourceTopologySegment() *schema.Resource {
+	return &schema.Resource{
+		Description:   "Manage FreeIPA topology segments (replication agreements)",
+		CreateContext: resourceTopologySegmentCreate,
+		ReadContext:   resourceTopologySegmentRead,
+		UpdateContext: resourceTopologySegmentUpdate,
+		DeleteContext: resourceTopologySegmentDelete,
+		Schema:        schemaTopologySegment(),
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceTopologySegmentImport,
+		},
+	}
+}
+
+// The ID of a segment is in the form of suffix:name
+func parseTopologySegmentID(id string) (string, string, error) {
+	suffix, cn, ok := strings.Cut(id, ":")
+	if !ok || suffix == "" || cn == "" {
+		return "", "", fmt.Errorf("invalid topology segment ID %q, expected suffix:name", id)
+	}
+
+	return suffix, cn, nil
+}
+
+func flattenTopologySegment(segment *api.TopologySegment) JSON {
+	flat := JSON{
+		"cn":                           segment.CN[0],
+		"leftnode":                     segment.LeftNode[0],
+		"rightnode":                    segment.RightNode[0],
+		"direction":                    "both",
+		"replicatedattributelist":      "",
+		"replicatedattributelisttotal": "",
+		"replicastripattrs":            "",
+		"replicatimeout":               0,
+		"replicaenabled":               true,
+	}
+
+	if len(segment.Direction) > 0 {
+		flat["direction"] = segment.Direction[0]
+	}
+	if len(segment.ReplicatedAttributeList) > 0 {
+		flat["replicatedattributelist"] = segment.ReplicatedAttributeList[0]
+	}
+	if len(segment.ReplicatedAttributeListTotal) > 0 {
+		flat["replicatedattributelisttotal"] = segment.ReplicatedAttributeListTotal[0]
+	}
+	if len(segment.ReplicaStripAttrs) > 0 {
+		flat["replicastripattrs"] = segment.ReplicaStripAttrs[0]
+	}
+	if len(segment.ReplicaTimeout) > 0 {
+		timeout, _ := segment.ReplicaTimeout[0].Int64()
+		flat["replicatimeout"] = int(timeout)
+	}
+	if len(segment.ReplicaEnabled) > 0 {
+		flat["replicaenabled"] = segment.ReplicaEnabled[0] != "off"
+	}
+
+	return flat
+}
+
+func replicaEnabledOption(enabled bool) string {
+	if enabled {
+		return "on"
+	}
+
+	return "off"
+}
+
+func resourceTopologySegmentCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*api.APIClient)
+
+	options := JSON{
+		"iparepltoposegmentleftnode":  d.Get("leftnode").(string),
+		"iparepltoposegmentrightnode": d.Get("rightnode").(string),
+		"iparepltoposegmentdirection": d.Get("direction").(string),
+	}
+	if val, ok := d.GetOk("replicatedattributelist"); ok {
+		options["nsds5replicatedattributelist"] = val.(string)
+	}
+	if val, ok := d.GetOk("replicatedattributelisttotal"); ok {
+		options["nsds5replicatedattributelisttotal"] = val.(string)
+	}
+	if val, ok := d.GetOk("replicastripattrs"); ok {
+		options["nsds5replicastripattrs"] = val.(string)
+	}
+	if val, ok := d.GetOk("replicatimeout"); ok {
+		options["nsds5replicatimeout"] = val.(int)
+	}
+	if !d.Get("replicaenabled").(bool) {
+		options["nsds5replicaenabled"] = replicaEnabledOption(false)
+	}
+
+	suffix := d.Get("suffix").(string)
+	segment, err := client.TopologySegmentAdd(suffix, d.Get("cn").(string), options)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(suffix + ":" + segment.CN[0])
+
+	return resourceTopologySegmentRead(ctx, d, m)
+}
+
+func resourceTopologySegmentRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	suffix, cn, err := parseTopologySegmentID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	client := m.(*api.APIClient)
+	segment, err := client.TopologySegmentShow(suffix, cn, JSON{
+		"all": true, // Otherwise we don't get the replication attributes
+	})
+	if err != nil {
+		if err.(*api.APIError).Code == 4001 { // Segment not found
+			d.SetId("")
+			return diags
+		}
+		return diag.FromErr(err)
+	}
+
+	if err := d.Set("suffix", suffix); err != nil {
+		return diag.FromErr(err)
+	}
+
+	for key, value := range flattenTopologySegment(segment) {
+		if err := d.Set(key, value); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return diags
+}
+
+func resourceTopologySegmentUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*api.APIClient)
+
+	options := JSON{}
+	if d.HasChange("replicatedattributelist") {
+		options["nsds5replicatedattributelist"] = d.Get("replicatedattributelist").(string)
+	}
+	if d.HasChange("replicatedattributelisttotal") {
+		options["nsds5replicatedattributelisttotal"] = d.Get("replicatedattributelisttotal").(string)
+	}
+	if d.HasChange("replicastripattrs") {
+		options["nsds5replicastripattrs"] = d.Get("replicastripattrs").(string)
+	}
+	if d.HasChange("replicatimeout") {
+		options["nsds5replicatimeout"] = d.Get("replicatimeout").(int)
+	}
+	if d.HasChange("replicaenabled") {
+		options["nsds5replicaenabled"] = replicaEnabledOption(d.Get("replicaenabled").(bool))
+	}
+
+	if len(options) > 0 {
+		_, err := client.TopologySegmentMod(d.Get("suffix").(string), d.Get("cn").(string), options)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return resourceTopologySegmentRead(ctx, d, m)
+}
+
+func resourceTopologySegmentDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	client := m.(*api.APIClient)
+	_, err := client.TopologySegmentDel(d.Get("suffix").(string), d.Get("cn").(string), nil)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diags
+}
+
+func resourceTopologySegmentImport(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	if _, _, err := parseTopologySegmentID(d.Id()); err != nil {
+		return nil, err
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
@@ -2,6 +2,11 @@ package freeipa
 
 import (
 	"context"
+	"crypto/x509"
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
 
 	api "terraform-provider-freeipa/freeipa/api"
 
@@ -35,12 +40,40 @@ func schemaUser() map[string]*schema.Schema {
 			Required:         true,
 			ValidateDiagFunc: validation.ToDiagFunc(validation.StringIsNotWhiteSpace),
 		},
-		"password": { // TODO: fix password update after a resource import
-			Description:      "User password",
+		"password": {
+			Description:      "User password, kept in sync with the configuration\nIf not specified, the password is left untouched. Prefer initial_password or random_password to avoid managing the password afterwards.",
 			Type:             schema.TypeString,
-			Required:         true,
+			Optional:         true,
+			Sensitive:        true,
+			ConflictsWith:    []string{"initial_password", "random_password"},
+			ValidateDiagFunc: validation.ToDiagFunc(validation.StringIsNotWhiteSpace),
+		},
+		"initial_password": {
+			Description:      "Password set when the user is created, ignored afterwards (including after an import)",
+			Type:             schema.TypeString,
+			Optional:         true,
 			Sensitive:        true,
+			ConflictsWith:    []string{"password", "random_password"},
 			ValidateDiagFunc: validation.ToDiagFunc(validation.StringIsNotWhiteSpace),
+			DiffSuppressFunc: func(k, oldValue, newValue string, d *schema.ResourceData) bool {
+				return d.Id() != ""
+			},
+		},
+		"random_password": {
+			Description:   "Generate a random password when the user is created, ignored afterwards (including after an import)\nThe generated password is available in randompassword.",
+			Type:          schema.TypeBool,
+			Optional:      true,
+			Default:       false,
+			ConflictsWith: []string{"password", "initial_password"},
+			DiffSuppressFunc: func(k, oldValue, newValue string, d *schema.ResourceData) bool {
+				return d.Id() != ""
+			},
+		},
+		"randompassword": {
+			Description: "Random password generated by the server",
+			Type:        schema.TypeString,
+			Computed:    true,
+			Sensitive:   true,
 		},
 		"krbpasswordexpiration": {
 			Description:      "Password expiration date (in RFC3339 format)\nIf not specified, the password will be immediately expired. This follows the default behavior of the API.",
@@ -49,6 +82,12 @@ func schemaUser() map[string]*schema.Schema {
 			Computed:         true,
 			ValidateDiagFunc: validation.ToDiagFunc(validation.IsRFC3339Time),
 		},
+		"krbprincipalexpiration": {
+			Description:      "Kerberos principal expiration date (in RFC3339 format)\nIf not specified, the principal never expires.",
+			Type:             schema.TypeString,
+			Optional:         true,
+			ValidateDiagFunc: validation.ToDiagFunc(validation.IsRFC3339Time),
+		},
 		"mail": {
 			Description: "Email addresses\nIf not specified, no email will be set. Note that this DOES NOT follows the API default behavior (that would have been to create UID@REALM email by default).",
 			Type:        schema.TypeList,
@@ -64,9 +103,370 @@ func schemaUser() map[string]*schema.Schema {
 			Optional:    true,
 			Computed:    true,
 		},
+		"loginshell": {
+			Description:      "Login shell\nIf not specified, the default shell will be used.",
+			Type:             schema.TypeString,
+			Optional:         true,
+			Computed:         true,
+			ValidateDiagFunc: validation.ToDiagFunc(validation.StringIsNotWhiteSpace),
+		},
+		"uidnumber": {
+			Description:      "User ID number\nIf not specified, a number will be automatically assigned.",
+			Type:             schema.TypeInt,
+			Optional:         true,
+			Computed:         true,
+			ValidateDiagFunc: validation.ToDiagFunc(validation.IntAtLeast(1)),
+		},
+		"gidnumber": {
+			Description:      "Group ID number of the primary group\nIf not specified, the ID of the user private group will be used.",
+			Type:             schema.TypeInt,
+			Optional:         true,
+			Computed:         true,
+			ValidateDiagFunc: validation.ToDiagFunc(validation.IntAtLeast(1)),
+		},
+		"gecos": {
+			Description:      "GECOS field\nIf not specified, the full name will be used.",
+			Type:             schema.TypeString,
+			Optional:         true,
+			Computed:         true,
+			ValidateDiagFunc: validation.ToDiagFunc(validation.StringIsNotWhiteSpace),
+		},
+		"displayname": {
+			Description:      "Display name\nIf not specified, it will be generated from the first and last names.",
+			Type:             schema.TypeString,
+			Optional:         true,
+			Computed:         true,
+			ValidateDiagFunc: validation.ToDiagFunc(validation.StringIsNotWhiteSpace),
+		},
+		"initials": {
+			Description:      "Initials\nIf not specified, they will be generated from the first and last names.",
+			Type:             schema.TypeString,
+			Optional:         true,
+			Computed:         true,
+			ValidateDiagFunc: validation.ToDiagFunc(validation.StringIsNotWhiteSpace),
+		},
+		"cn": {
+			Description:      "Full name\nIf not specified, it will be generated from the first and last names.",
+			Type:             schema.TypeString,
+			Optional:         true,
+			Computed:         true,
+			ValidateDiagFunc: validation.ToDiagFunc(validation.StringIsNotWhiteSpace),
+		},
+		"title": {
+			Description:      "Job title",
+			Type:             schema.TypeString,
+			Optional:         true,
+			ValidateDiagFunc: validation.ToDiagFunc(validation.StringIsNotWhiteSpace),
+		},
+		"telephonenumber": {
+			Description: "Telephone numbers",
+			Type:        schema.TypeList,
+			Optional:    true,
+			Elem: &schema.Schema{
+				Type:             schema.TypeString,
+				ValidateDiagFunc: validation.ToDiagFunc(validation.StringIsNotWhiteSpace),
+			},
+		},
+		"mobile": {
+			Description: "Mobile telephone numbers",
+			Type:        schema.TypeList,
+			Optional:    true,
+			Elem: &schema.Schema{
+				Type:             schema.TypeString,
+				ValidateDiagFunc: validation.ToDiagFunc(validation.StringIsNotWhiteSpace),
+			},
+		},
+		"pager": {
+			Description: "Pager numbers",
+			Type:        schema.TypeList,
+			Optional:    true,
+			Elem: &schema.Schema{
+				Type:             schema.TypeString,
+				ValidateDiagFunc: validation.ToDiagFunc(validation.StringIsNotWhiteSpace),
+			},
+		},
+		"facsimiletelephonenumber": {
+			Description: "Fax numbers",
+			Type:        schema.TypeList,
+			Optional:    true,
+			Elem: &schema.Schema{
+				Type:             schema.TypeString,
+				ValidateDiagFunc: validation.ToDiagFunc(validation.StringIsNotWhiteSpace),
+			},
+		},
+		"street": {
+			Description:      "Street address",
+			Type:             schema.TypeString,
+			Optional:         true,
+			ValidateDiagFunc: validation.ToDiagFunc(validation.StringIsNotWhiteSpace),
+		},
+		"l": {
+			Description:      "City",
+			Type:             schema.TypeString,
+			Optional:         true,
+			ValidateDiagFunc: validation.ToDiagFunc(validation.StringIsNotWhiteSpace),
+		},
+		"st": {
+			Description:      "State or province",
+			Type:             schema.TypeString,
+			Optional:         true,
+			ValidateDiagFunc: validation.ToDiagFunc(validation.StringIsNotWhiteSpace),
+		},
+		"postalcode": {
+			Description:      "ZIP code",
+			Type:             schema.TypeString,
+			Optional:         true,
+			ValidateDiagFunc: validation.ToDiagFunc(validation.StringIsNotWhiteSpace),
+		},
+		"ou": {
+			Description:      "Organizational unit",
+			Type:             schema.TypeString,
+			Optional:         true,
+			ValidateDiagFunc: validation.ToDiagFunc(validation.StringIsNotWhiteSpace),
+		},
+		"employeenumber": {
+			Description:      "Employee number",
+			Type:             schema.TypeString,
+			Optional:         true,
+			ValidateDiagFunc: validation.ToDiagFunc(validation.StringIsNotWhiteSpace),
+		},
+		"employeetype": {
+			Description:      "Employee type",
+			Type:             schema.TypeString,
+			Optional:         true,
+			ValidateDiagFunc: validation.ToDiagFunc(validation.StringIsNotWhiteSpace),
+		},
+		"preferredlanguage": {
+			Description:      "Preferred language",
+			Type:             schema.TypeString,
+			Optional:         true,
+			ValidateDiagFunc: validation.ToDiagFunc(validation.StringIsNotWhiteSpace),
+		},
+		"departmentnumber": {
+			Description: "Department numbers",
+			Type:        schema.TypeList,
+			Optional:    true,
+			Elem: &schema.Schema{
+				Type:             schema.TypeString,
+				ValidateDiagFunc: validation.ToDiagFunc(validation.StringIsNotWhiteSpace),
+			},
+		},
+		"carlicense": {
+			Description: "Car licenses",
+			Type:        schema.TypeList,
+			Optional:    true,
+			Elem: &schema.Schema{
+				Type:             schema.TypeString,
+				ValidateDiagFunc: validation.ToDiagFunc(validation.StringIsNotWhiteSpace),
+			},
+		},
+		"sshpubkey": {
+			Description: "SSH public keys\nKeys are compared without their comment, so that changing it does not produce a diff. If not specified, the existing keys (e.g. uploaded by the user) are left untouched.",
+			Type:        schema.TypeSet,
+			Optional:    true,
+			Computed:    true,
+			Set: func(value interface{}) int {
+				return schema.HashString(normalizeSSHPublicKey(value.(string)))
+			},
+			Elem: &schema.Schema{
+				Type:             schema.TypeString,
+				ValidateDiagFunc: validation.ToDiagFunc(validation.StringIsNotWhiteSpace),
+			},
+		},
+		"sshpubkeyfp": {
+			Description: "Fingerprints of the SSH public keys",
+			Type:        schema.TypeList,
+			Computed:    true,
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
+			},
+		},
+		"user_auth_type": {
+			Description: `Types of supported authentication (must be among "password", "radius", "otp", "pkinit", "hardened", "idp" and "passkey")\nIf not specified, the default types of the global configuration are used.`,
+			Type:        schema.TypeSet,
+			Optional:    true,
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
+				ValidateDiagFunc: validation.ToDiagFunc(validation.StringInSlice([]string{
+					"password", "radius", "otp", "pkinit", "hardened", "idp", "passkey",
+				}, false)),
+			},
+		},
+		"idp": {
+			Description:      "External identity provider (name of a freeipa_idp)",
+			Type:             schema.TypeString,
+			Optional:         true,
+			ValidateDiagFunc: validation.ToDiagFunc(validation.StringIsNotWhiteSpace),
+		},
+		"idp_user_id": {
+			Description:      "User identifier in the external identity provider\nIf not specified, the login of the user is used.",
+			Type:             schema.TypeString,
+			Optional:         true,
+			RequiredWith:     []string{"idp"},
+			ValidateDiagFunc: validation.ToDiagFunc(validation.StringIsNotWhiteSpace),
+		},
+		"manager": {
+			Description:      "Manager (login of another user)",
+			Type:             schema.TypeString,
+			Optional:         true,
+			ValidateDiagFunc: validation.ToDiagFunc(validation.StringIsNotWhiteSpace),
+		},
 	}
 }
 
+// Strip the comment and extra whitespace of an SSH public key ([options] type base64 [comment])
+func normalizeSSHPublicKey(key string) string {
+	fields := strings.Fields(key)
+	for i, field := range fields {
+		if strings.HasPrefix(field, "ssh-") || strings.HasPrefix(field, "ecdsa-") || strings.HasPrefix(field, "sk-") {
+			if i+1 < len(fields) {
+				return strings.Join(fields[:i+2], " ")
+			}
+		}
+	}
+
+	return strings.Join(fields, " ")
+}
+
+// Optional attributes named after their API option, shared by active and stage users
+var userStringAttributes = []string{
+	"loginshell", "gecos", "displayname", "initials", "cn", "title", "street", "l", "st",
+	"postalcode", "ou", "employeenumber", "employeetype", "preferredlanguage", "manager",
+}
+var userListAttributes = []string{
+	"telephonenumber", "mobile", "pager", "facsimiletelephonenumber", "departmentnumber", "carlicense",
+}
+var userIntAttributes = []string{"uidnumber", "gidnumber"}
+
+// LDAP attributes modeled by the other attributes of active users, that cannot be extra attributes
+var userModeledAttributes = []string{
+	"uid", "givenname", "sn", "mail", "userpassword", "krbpasswordexpiration", "krbprincipalexpiration", "homedirectory",
+	"ipasshpubkey", "ipauserauthtype", "ipaidpconfiglink", "ipaidpsub", "ipapasskey", "ipacertmapdata",
+	"krbprincipalname", "krbcanonicalname", "usercertificate", "nsaccountlock",
+}
+
+// Attributes only available to active users
+func schemaActiveUser() map[string]*schema.Schema {
+	user := schemaUser()
+
+	user["passkey"] = &schema.Schema{
+		Description: "Passkey mappings (in the form of passkey:credential_id,public_key)\nIf not specified, the existing mappings (e.g. passkeys registered by the user) are left untouched.",
+		Type:        schema.TypeSet,
+		Optional:    true,
+		Computed:    true,
+		Elem: &schema.Schema{
+			Type:             schema.TypeString,
+			ValidateDiagFunc: validation.ToDiagFunc(validation.StringIsNotWhiteSpace),
+		},
+	}
+
+	user["principal_aliases"] = &schema.Schema{
+		Description: "Kerberos principal aliases (in the form of alias@REALM)\nThe canonical principal of the user is not part of the aliases. If not specified, the existing aliases are left untouched.",
+		Type:        schema.TypeSet,
+		Optional:    true,
+		Computed:    true,
+		Elem: &schema.Schema{
+			Type:             schema.TypeString,
+			ValidateDiagFunc: validation.ToDiagFunc(validation.StringMatch(regexp.MustCompile(`^[^@\s]+@[^@\s]+$`), "must be in the form of alias@REALM")),
+		},
+	}
+
+	user["certificates"] = &schema.Schema{
+		Description: "Certificates (in PEM format or base64 encoded DER)\nIf not specified, the existing certificates (e.g. issued by the IPA CA) are left untouched.",
+		Type:        schema.TypeSet,
+		Optional:    true,
+		Computed:    true,
+		Set: func(value interface{}) int {
+			return schema.HashString(normalizeCertificate(value.(string)))
+		},
+		Elem: &schema.Schema{
+			Type:             schema.TypeString,
+			ValidateDiagFunc: validation.ToDiagFunc(StringIsCertificate),
+		},
+	}
+
+	user["certificate_details"] = &schema.Schema{
+		Description: "Details of the certificates",
+		Type:        schema.TypeList,
+		Computed:    true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"certificate": {
+					Description: "Certificate (base64 encoded DER)",
+					Type:        schema.TypeString,
+					Computed:    true,
+				},
+				"subject": {
+					Description: "Subject DN",
+					Type:        schema.TypeString,
+					Computed:    true,
+				},
+				"issuer": {
+					Description: "Issuer DN",
+					Type:        schema.TypeString,
+					Computed:    true,
+				},
+				"serial_number": {
+					Description: "Serial number (in decimal)",
+					Type:        schema.TypeString,
+					Computed:    true,
+				},
+				"not_after": {
+					Description: "Expiration date (in RFC3339 format)",
+					Type:        schema.TypeString,
+					Computed:    true,
+				},
+			},
+		},
+	}
+
+	user["certmapdata"] = &schema.Schema{
+		Description: "Certificate mapping data (in the form of X509:<I>issuer<S>subject)\nIf not specified, the existing mapping data (e.g. added by smart card enrolment tooling) is left untouched.",
+		Type:        schema.TypeSet,
+		Optional:    true,
+		Computed:    true,
+		Elem: &schema.Schema{
+			Type:             schema.TypeString,
+			ValidateDiagFunc: validation.ToDiagFunc(validation.StringIsNotWhiteSpace),
+		},
+	}
+
+	user["disabled"] = &schema.Schema{
+		Description: "Whether the user account is disabled",
+		Type:        schema.TypeBool,
+		Optional:    true,
+		Default:     false,
+	}
+
+	user["preserve_on_destroy"] = &schema.Schema{
+		Description: "Preserve the user when the resource is destroyed instead of permanently deleting it\nA preserved user keeps its UID and can be restored later.",
+		Type:        schema.TypeBool,
+		Optional:    true,
+		Default:     false,
+	}
+
+	user["restore_preserved"] = &schema.Schema{
+		Description: "Restore the user if it already exists as a preserved user instead of failing to create it\nThe attributes of the restored user are then updated to match the configuration.",
+		Type:        schema.TypeBool,
+		Optional:    true,
+		Default:     false,
+	}
+
+	modeled := make([]string, 0)
+	for _, keys := range [][]string{userModeledAttributes, userStringAttributes, userListAttributes, userIntAttributes} {
+		modeled = append(modeled, keys...)
+	}
+	user["extra_attributes"] = schemaExtraAttributes(modeled...)
+
+	user["unlock_trigger"] = &schema.Schema{
+		Description: "Arbitrary value (e.g. a ticket number or a timestamp), changing it unlocks the user account on all servers",
+		Type:        schema.TypeString,
+		Optional:    true,
+	}
+
+	return user
+}
+
 func resourceUser() *schema.Resource {
 	return &schema.Resource{
 		Description:   "Manage FreeIPA users",
@@ -74,7 +474,7 @@ func resourceUser() *schema.Resource {
 		ReadContext:   resourceUserRead,
 		UpdateContext: resourceUserUpdate,
 		DeleteContext: resourceUserDelete,
-		Schema:        schemaUser(),
+		Schema:        schemaActiveUser(),
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
@@ -92,6 +492,12 @@ func flattenUser(user *api.User) JSON {
 		flat["krbpasswordexpiration"] = ""
 	}
 
+	if len(user.KrbPrincipalExpiration) > 0 {
+		flat["krbprincipalexpiration"] = user.KrbPrincipalExpiration[0].DateTime.String()
+	} else {
+		flat["krbprincipalexpiration"] = ""
+	}
+
 	if len(user.GivenName) > 0 {
 		flat["givenname"] = user.GivenName[0]
 	} else {
@@ -116,33 +522,440 @@ func flattenUser(user *api.User) JSON {
 		flat["homedirectory"] = ""
 	}
 
+	if len(user.UIDNumber) > 0 {
+		uidnumber, _ := user.UIDNumber[0].Int64()
+		flat["uidnumber"] = int(uidnumber)
+	} else {
+		flat["uidnumber"] = 0
+	}
+
+	if len(user.GIDNumber) > 0 {
+		gidnumber, _ := user.GIDNumber[0].Int64()
+		flat["gidnumber"] = int(gidnumber)
+	} else {
+		flat["gidnumber"] = 0
+	}
+
+	if len(user.SSHPubKey) > 0 {
+		flat["sshpubkey"] = user.SSHPubKey
+	} else {
+		flat["sshpubkey"] = make([]string, 0)
+	}
+
+	if len(user.SSHPubKeyFP) > 0 {
+		flat["sshpubkeyfp"] = user.SSHPubKeyFP
+	} else {
+		flat["sshpubkeyfp"] = make([]string, 0)
+	}
+
+	if len(user.UserAuthType) > 0 {
+		flat["user_auth_type"] = user.UserAuthType
+	} else {
+		flat["user_auth_type"] = make([]string, 0)
+	}
+
+	if len(user.IdPConfigLink) > 0 {
+		flat["idp"] = user.IdPConfigLink[0]
+	} else {
+		flat["idp"] = ""
+	}
+
+	if len(user.IdPSub) > 0 {
+		flat["idp_user_id"] = user.IdPSub[0]
+	} else {
+		flat["idp_user_id"] = ""
+	}
+
+	singleValues := map[string][]string{
+		"loginshell":        user.LoginShell,
+		"gecos":             user.Gecos,
+		"displayname":       user.DisplayName,
+		"initials":          user.Initials,
+		"cn":                user.CN,
+		"title":             user.Title,
+		"street":            user.Street,
+		"l":                 user.L,
+		"st":                user.ST,
+		"postalcode":        user.PostalCode,
+		"ou":                user.OU,
+		"employeenumber":    user.EmployeeNumber,
+		"employeetype":      user.EmployeeType,
+		"preferredlanguage": user.PreferredLanguage,
+		"manager":           user.Manager,
+	}
+	for key, values := range singleValues {
+		if len(values) > 0 {
+			flat[key] = values[0]
+		} else {
+			flat[key] = ""
+		}
+	}
+
+	multiValues := map[string][]string{
+		"telephonenumber":          user.TelephoneNumber,
+		"mobile":                   user.Mobile,
+		"pager":                    user.Pager,
+		"facsimiletelephonenumber": user.FacsimileTelephoneNumber,
+		"departmentnumber":         user.DepartmentNumber,
+		"carlicense":               user.CarLicense,
+	}
+	for key, values := range multiValues {
+		if len(values) > 0 {
+			flat[key] = values
+		} else {
+			flat[key] = make([]string, 0)
+		}
+	}
+
 	return flat
 }
 
-func resourceUserCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	client := m.(*api.APIClient)
-
+// Options used to create a user, shared by active and stage users
+func expandUserCreateOptions(d *schema.ResourceData) JSON {
 	options := JSON{
-		"userpassword":          d.Get("password").(string),
 		"krbpasswordexpiration": d.Get("krbpasswordexpiration").(string),
 		"mail":                  d.Get("mail").([]interface{}),
 	}
+	if expiration := d.Get("krbprincipalexpiration").(string); expiration != "" {
+		options["krbprincipalexpiration"] = expiration
+	}
+	if password := d.Get("password").(string); password != "" {
+		options["userpassword"] = password
+	}
+	if password := d.Get("initial_password").(string); password != "" {
+		options["userpassword"] = password
+	}
+	if d.Get("random_password").(bool) {
+		options["random"] = true
+	}
 	homedir := d.Get("homedirectory").(string)
 	if homedir != "" {
 		options["homedirectory"] = homedir
 	}
 
-	user, err := client.UserAdd(d.Get("uid").(string),
-		d.Get("givenname").(string),
-		d.Get("sn").(string),
-		options,
-	)
+	for _, key := range userStringAttributes {
+		if value := d.Get(key).(string); value != "" {
+			options[key] = value
+		}
+	}
+	for _, key := range userListAttributes {
+		if value := d.Get(key).([]interface{}); len(value) > 0 {
+			options[key] = value
+		}
+	}
+	for _, key := range userIntAttributes {
+		if value := d.Get(key).(int); value != 0 {
+			options[key] = value
+		}
+	}
+	if sshpubkey := d.Get("sshpubkey").(*schema.Set); sshpubkey.Len() > 0 {
+		options["ipasshpubkey"] = sshpubkey.List()
+	}
+	if authTypes := d.Get("user_auth_type").(*schema.Set); authTypes.Len() > 0 {
+		options["ipauserauthtype"] = authTypes.List()
+	}
+	if idp := d.Get("idp").(string); idp != "" {
+		options["ipaidpconfiglink"] = idp
+	}
+	if idpUserID := d.Get("idp_user_id").(string); idpUserID != "" {
+		options["ipaidpsub"] = idpUserID
+	}
+
+	return options
+}
+
+// Options used to update a user, shared by active and stage users
+func expandUserUpdateOptions(d *schema.ResourceData) JSON {
+	options := JSON{}
+	if d.HasChange("givenname") {
+		options["givenname"] = d.Get("givenname").(string)
+	}
+	if d.HasChange("sn") {
+		options["sn"] = d.Get("sn").(string)
+	}
+	if password := d.Get("password").(string); d.HasChange("password") && password != "" {
+		options["userpassword"] = password
+	}
+	if d.HasChange("krbpasswordexpiration") {
+		options["krbpasswordexpiration"] = d.Get("krbpasswordexpiration").(string)
+	}
+	if d.HasChange("krbprincipalexpiration") {
+		options["krbprincipalexpiration"] = d.Get("krbprincipalexpiration").(string)
+	}
+	if d.HasChange("mail") {
+		options["mail"] = d.Get("mail").([]interface{})
+	}
+	if d.HasChange("homedirectory") {
+		options["homedirectory"] = d.Get("homedirectory").(string)
+	}
+
+	if d.HasChange("sshpubkey") {
+		options["ipasshpubkey"] = d.Get("sshpubkey").(*schema.Set).List()
+	}
+	if d.HasChange("user_auth_type") {
+		options["ipauserauthtype"] = d.Get("user_auth_type").(*schema.Set).List()
+	}
+	if d.HasChange("idp") {
+		options["ipaidpconfiglink"] = d.Get("idp").(string)
+	}
+	if d.HasChange("idp_user_id") {
+		options["ipaidpsub"] = d.Get("idp_user_id").(string)
+	}
+
+	for _, keys := range [][]string{userStringAttributes, userListAttributes, userIntAttributes} {
+		for _, key := range keys {
+			if d.HasChange(key) {
+				options[key] = d.Get(key)
+			}
+		}
+	}
+
+	return options
+}
+
+// The identity provider is checked before being linked, to report a meaningful error
+func validateUserIdP(d *schema.ResourceData, client *api.APIClient) error {
+	idp := d.Get("idp").(string)
+	if idp == "" || !d.HasChange("idp") {
+		return nil
+	}
+
+	_, err := client.IdentityProviderShow(idp, nil)
+	if err != nil {
+		if err.(*api.APIError).Code == 4001 { // IdP not found
+			return fmt.Errorf("identity provider %q does not exist", idp)
+		}
+		return err
+	}
+
+	return nil
+}
+
+func flattenActiveUser(user *api.User) JSON {
+	flat := flattenUser(user)
+
+	if len(user.Passkey) > 0 {
+		flat["passkey"] = user.Passkey
+	} else {
+		flat["passkey"] = make([]string, 0)
+	}
+
+	if len(user.CertMapData) > 0 {
+		flat["certmapdata"] = user.CertMapData
+	} else {
+		flat["certmapdata"] = make([]string, 0)
+	}
+
+	certificates := make([]string, 0, len(user.UserCertificate))
+	details := make([]JSON, 0, len(user.UserCertificate))
+	for _, certificate := range user.UserCertificate {
+		certificates = append(certificates, certificate.Value)
+		details = append(details, flattenCertificateDetails(certificate.Value))
+	}
+	flat["certificates"] = certificates
+	flat["certificate_details"] = details
+
+	aliases := make([]string, 0, len(user.KrbPrincipalName))
+	for _, principal := range user.KrbPrincipalName {
+		if len(user.KrbCanonicalName) > 0 && principal == user.KrbCanonicalName[0] {
+			continue
+		}
+		aliases = append(aliases, principal)
+	}
+	flat["principal_aliases"] = aliases
+
+	flat["disabled"] = user.NSAccountLock.Bool()
+
+	return flat
+}
+
+func flattenCertificateDetails(value string) JSON {
+	flat := JSON{
+		"certificate":   value,
+		"subject":       "",
+		"issuer":        "",
+		"serial_number": "",
+		"not_after":     "",
+	}
+
+	der, err := decodeCertificate(value)
+	if err != nil {
+		return flat
+	}
+	certificate, err := x509.ParseCertificate(der)
+	if err != nil {
+		return flat
+	}
+
+	flat["subject"] = certificate.Subject.String()
+	flat["issuer"] = certificate.Issuer.String()
+	flat["serial_number"] = certificate.SerialNumber.String()
+	flat["not_after"] = certificate.NotAfter.UTC().Format(time.RFC3339)
+
+	return flat
+}
+
+// Certificates are sent to the API as base64 encoded DER, whatever their format in the configuration
+func withNormalizedCertificates(method func(string, JSON) (*api.User, error)) func(string, JSON) (*api.User, error) {
+	return func(uid string, options JSON) (*api.User, error) {
+		certificates := make([]string, 0)
+		for _, certificate := range options["usercertificate"].([]interface{}) {
+			certificates = append(certificates, normalizeCertificate(certificate.(string)))
+		}
+		options["usercertificate"] = certificates
+
+		return method(uid, options)
+	}
+}
+
+// Set attribute of active users managed with dedicated add and remove API methods
+type userSetAttribute struct {
+	key    string // Attribute name in the schema
+	option string // Option name in the API
+	add    func(uid string, options JSON) (*api.User, error)
+	remove func(uid string, options JSON) (*api.User, error)
+}
+
+func userSetAttributes(client *api.APIClient) []userSetAttribute {
+	return []userSetAttribute{
+		{"passkey", "ipapasskey", client.UserAddPasskey, client.UserRemovePasskey},
+		{"certmapdata", "ipacertmapdata", client.UserAddCertMapData, client.UserRemoveCertMapData},
+		{"principal_aliases", "krbprincipalname", client.UserAddPrincipal, client.UserRemovePrincipal},
+		{"certificates", "usercertificate", withNormalizedCertificates(client.UserAddCert), withNormalizedCertificates(client.UserRemoveCert)},
+	}
+}
+
+func (a userSetAttribute) update(d *schema.ResourceData) error {
+	return updateSetAttribute(d, a.key, a.option, a.add, a.remove)
+}
+
+func (a userSetAttribute) reconcile(d *schema.ResourceData, user *api.User) error {
+	return reconcileSetAttribute(d, a.key, a.option, flattenActiveUser(user)[a.key].([]string), a.add, a.remove)
+}
+
+func updateUserDisabled(d *schema.ResourceData, client *api.APIClient) error {
+	var err error
+	if d.Get("disabled").(bool) {
+		_, err = client.UserDisable(d.Id())
+	} else {
+		_, err = client.UserEnable(d.Id())
+	}
+
+	return err
+}
+
+// Restore a preserved user and update it to match the configuration
+// The set attributes are not updated, they are reconciled against the returned entry afterwards
+// Returns nil if there is no preserved user to restore
+func restorePreservedUser(d *schema.ResourceData, client *api.APIClient) (*api.User, error) {
+	uid := d.Get("uid").(string)
+
+	user, err := client.UserShow(uid, nil)
+	if err != nil {
+		if err.(*api.APIError).Code == 4001 { // User not found
+			return nil, nil
+		}
+		return nil, err
+	}
+	if !user.Preserved.Bool() {
+		return nil, nil
+	}
+
+	_, err = client.UserUndel(uid)
+	if err != nil {
+		return nil, err
+	}
+
+	options := expandUserCreateOptions(d)
+	options["givenname"] = d.Get("givenname").(string)
+	options["sn"] = d.Get("sn").(string)
+	expandExtraAttributes(d, options)
+
+	restored, err := client.UserMod(uid, options)
 	if err != nil {
+		if apiErr, ok := err.(*api.APIError); !ok || apiErr.Code != 4202 { // User already up to date
+			return nil, err
+		}
+	}
+
+	// The entry returned by user_mod does not contain all the attributes
+	user, err = client.UserShow(uid, JSON{
+		"all": true,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if restored != nil {
+		user.RandomPassword = restored.RandomPassword
+	}
+
+	return user, nil
+}
+
+// The random password is only returned when it is generated
+func setRandomPassword(d *schema.ResourceData, user *api.User) error {
+	if user.RandomPassword == "" {
+		return nil
+	}
+
+	return d.Set("randompassword", user.RandomPassword)
+}
+
+func resourceUserCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*api.APIClient)
+
+	if err := validateUserIdP(d, client); err != nil {
 		return diag.FromErr(err)
 	}
 
+	var user *api.User
+	var err error
+	if d.Get("restore_preserved").(bool) {
+		user, err = restorePreservedUser(d, client)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+	restored := user != nil
+
+	if user == nil {
+		options := expandUserCreateOptions(d)
+		expandExtraAttributes(d, options)
+
+		user, err = client.UserAdd(d.Get("uid").(string),
+			d.Get("givenname").(string),
+			d.Get("sn").(string),
+			options,
+		)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
 	d.SetId(user.UID[0])
 
+	if err := setRandomPassword(d, user); err != nil {
+		return diag.FromErr(err)
+	}
+
+	// The values kept by a restored user must not be added again
+	for _, attribute := range userSetAttributes(client) {
+		if restored {
+			err = attribute.reconcile(d, user)
+		} else {
+			err = attribute.update(d)
+		}
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	if d.Get("disabled").(bool) {
+		if err := updateUserDisabled(d, client); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
 	return resourceUserRead(ctx, d, m)
 }
 
@@ -161,40 +974,64 @@ func resourceUserRead(ctx context.Context, d *schema.ResourceData, m interface{}
 		return diag.FromErr(err)
 	}
 
-	for key, value := range flattenUser(user) {
+	// A preserved user has been deleted
+	if user.Preserved.Bool() {
+		d.SetId("")
+		return diags
+	}
+
+	for key, value := range flattenActiveUser(user) {
 		if err := d.Set(key, value); err != nil {
 			return diag.FromErr(err)
 		}
 	}
 
+	if err := readExtraAttributes(d, client, "user_show"); err != nil {
+		return diag.FromErr(err)
+	}
+
 	return diags
 }
 
 func resourceUserUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	client := m.(*api.APIClient)
 
-	options := JSON{}
-	if d.HasChange("givenname") {
-		options["givenname"] = d.Get("givenname").(string)
-	}
-	if d.HasChange("sn") {
-		options["sn"] = d.Get("sn").(string)
+	if err := validateUserIdP(d, client); err != nil {
+		return diag.FromErr(err)
 	}
-	if d.HasChange("password") {
-		options["userpassword"] = d.Get("password").(string)
+
+	options := expandUserUpdateOptions(d)
+	if d.HasChange("extra_attributes") {
+		expandExtraAttributes(d, options)
 	}
-	if d.HasChange("krbpasswordexpiration") {
-		options["krbpasswordexpiration"] = d.Get("krbpasswordexpiration").(string)
+	if len(options) > 0 {
+		user, err := client.UserMod(d.Id(), options)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		if err := setRandomPassword(d, user); err != nil {
+			return diag.FromErr(err)
+		}
 	}
-	if d.HasChange("mail") {
-		options["mail"] = d.Get("mail").([]interface{})
+
+	for _, attribute := range userSetAttributes(client) {
+		if !d.HasChange(attribute.key) {
+			continue
+		}
+		if err := attribute.update(d); err != nil {
+			return diag.FromErr(err)
+		}
 	}
-	if d.HasChange("homedirectory") {
-		options["homedirectory"] = d.Get("homedirectory").(string)
+
+	if d.HasChange("disabled") {
+		if err := updateUserDisabled(d, client); err != nil {
+			return diag.FromErr(err)
+		}
 	}
 
-	if d.HasChangeExcept("uid") {
-		_, err := client.UserMod(d.Id(), options)
+	if d.HasChange("unlock_trigger") {
+		_, err := client.UserUnlock(d.Id())
 		if err != nil {
 			return diag.FromErr(err)
 		}
@@ -206,8 +1043,13 @@ func resourceUserUpdate(ctx context.Context, d *schema.ResourceData, m interface
 func resourceUserDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
 
+	options := JSON{}
+	if d.Get("preserve_on_destroy").(bool) {
+		options["preserve"] = true
+	}
+
 	client := m.(*api.APIClient)
-	_, err := client.UserDel(d.Id(), nil)
+	_, err := client.UserDel(d.Id(), options)
 	if err != nil {
 		return diag.FromErr(err)
 	}
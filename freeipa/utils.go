@@ -0,0 +1,254 @@
+package freeipa
+
+import (
+	"encoding/base64"
+	"encoding/pem"
+	"fmt"
+	"sort"
+	"strings"
+
+	api "terraform-provider-freeipa/freeipa/api"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// Reconcile a set attribute using dedicated add and remove API methods
+// The values that are not wanted anymore are removed before the new ones are added
+func updateSetAttribute[T any](d *schema.ResourceData, key string, option string, add func(string, JSON) (*T, error), remove func(string, JSON) (*T, error)) error {
+	oldValues, newValues := d.GetChange(key)
+
+	return applySetAttribute(d, option, oldValues.(*schema.Set), newValues.(*schema.Set), add, remove)
+}
+
+// Reconcile a set attribute against the values currently on the server instead of the previous state
+// The attribute is left untouched if it is not set in the configuration
+func reconcileSetAttribute[T any](d *schema.ResourceData, key string, option string, current []string, add func(string, JSON) (*T, error), remove func(string, JSON) (*T, error)) error {
+	if d.GetRawConfig().GetAttr(key).IsNull() {
+		return nil
+	}
+
+	newValues := d.Get(key).(*schema.Set)
+	oldValues := schema.NewSet(newValues.F, make([]interface{}, 0, len(current)))
+	for _, value := range current {
+		oldValues.Add(value)
+	}
+
+	return applySetAttribute(d, option, oldValues, newValues, add, remove)
+}
+
+func applySetAttribute[T any](d *schema.ResourceData, option string, oldValues *schema.Set, newValues *schema.Set, add func(string, JSON) (*T, error), remove func(string, JSON) (*T, error)) error {
+	removed := oldValues.Difference(newValues)
+	if removed.Len() > 0 {
+		_, err := remove(d.Id(), JSON{
+			option: removed.List(),
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	added := newValues.Difference(oldValues)
+	if added.Len() > 0 {
+		_, err := add(d.Id(), JSON{
+			option: added.List(),
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Decode a certificate given either in PEM format or as base64 encoded DER
+func decodeCertificate(value string) ([]byte, error) {
+	if block, _ := pem.Decode([]byte(value)); block != nil {
+		return block.Bytes, nil
+	}
+
+	return base64.StdEncoding.DecodeString(strings.Join(strings.Fields(value), ""))
+}
+
+// Convert a certificate to base64 encoded DER, the format used by the API
+func normalizeCertificate(value string) string {
+	der, err := decodeCertificate(value)
+	if err != nil {
+		return strings.TrimSpace(value)
+	}
+
+	return base64.StdEncoding.EncodeToString(der)
+}
+
+// Arbitrary LDAP attributes, managed through setattr/addattr/delattr
+// The attributes modeled by the resource are rejected, otherwise both would fight over the same values
+func schemaExtraAttributes(modeled ...string) *schema.Schema {
+	// Object classes are maintained by the server, setting them would remove the default ones
+	denied := append([]string{"objectclass"}, modeled...)
+
+	return &schema.Schema{
+		Description: "Additional LDAP attributes, not modeled by the resource\nOnly the listed attributes are managed, and all their values are replaced by the configured ones: they must not have values added by the server or by other tools. An empty list of values removes the attribute.",
+		Type:        schema.TypeSet,
+		Optional:    true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"name": {
+					Description: "Attribute name (cannot be an attribute modeled by the resource, nor objectClass)",
+					Type:        schema.TypeString,
+					Required:    true,
+					ValidateDiagFunc: validation.ToDiagFunc(validation.All(
+						validation.StringIsNotWhiteSpace,
+						validation.StringNotInSlice(denied, true),
+					)),
+				},
+				"values": {
+					Description: "Attribute values",
+					Type:        schema.TypeSet,
+					Required:    true,
+					Elem: &schema.Schema{
+						Type: schema.TypeString,
+					},
+				},
+			},
+		},
+	}
+}
+
+func extraAttributesValues(value interface{}) map[string][]string {
+	attributes := make(map[string][]string)
+	for _, attribute := range value.(*schema.Set).List() {
+		attribute := attribute.(map[string]interface{})
+
+		values := make([]string, 0)
+		for _, value := range attribute["values"].(*schema.Set).List() {
+			values = append(values, value.(string))
+		}
+		attributes[attribute["name"].(string)] = values
+	}
+
+	return attributes
+}
+
+func containsString(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Add the setattr/addattr/delattr options reconciling extra_attributes to the given options
+// Newly managed attributes are set, the values of already managed ones are added or deleted
+func expandExtraAttributes(d *schema.ResourceData, options JSON) {
+	oldValue, newValue := d.GetChange("extra_attributes")
+	oldAttributes := extraAttributesValues(oldValue)
+	newAttributes := extraAttributesValues(newValue)
+
+	setattr := make([]string, 0)
+	addattr := make([]string, 0)
+	delattr := make([]string, 0)
+	for name, values := range newAttributes {
+		oldValues, managed := oldAttributes[name]
+		if !managed {
+			if len(values) == 0 {
+				setattr = append(setattr, name+"=")
+			}
+			for _, value := range values {
+				setattr = append(setattr, name+"="+value)
+			}
+			continue
+		}
+
+		for _, value := range values {
+			if !containsString(oldValues, value) {
+				addattr = append(addattr, name+"="+value)
+			}
+		}
+		for _, value := range oldValues {
+			if !containsString(values, value) {
+				delattr = append(delattr, name+"="+value)
+			}
+		}
+	}
+	for name, values := range oldAttributes {
+		if _, managed := newAttributes[name]; managed {
+			continue
+		}
+		for _, value := range values {
+			delattr = append(delattr, name+"="+value)
+		}
+	}
+
+	if len(setattr) > 0 {
+		sort.Strings(setattr)
+		options["setattr"] = setattr
+	}
+	if len(addattr) > 0 {
+		sort.Strings(addattr)
+		options["addattr"] = addattr
+	}
+	if len(delattr) > 0 {
+		sort.Strings(delattr)
+		options["delattr"] = delattr
+	}
+}
+
+// Values of an entry attribute, that can be wrapped (e.g. {"__base64__": ...})
+func entryAttributeValues(value interface{}) []string {
+	var items []interface{}
+	switch v := value.(type) {
+	case nil:
+		return make([]string, 0)
+	case []interface{}:
+		items = v
+	default:
+		items = []interface{}{v}
+	}
+
+	values := make([]string, 0, len(items))
+	for _, item := range items {
+		if wrapped, ok := item.(map[string]interface{}); ok && len(wrapped) == 1 {
+			for _, inner := range wrapped {
+				item = inner
+			}
+		}
+		values = append(values, fmt.Sprint(item))
+	}
+
+	return values
+}
+
+// Refresh the attributes listed in extra_attributes from the given *_show method
+func readExtraAttributes(d *schema.ResourceData, client *api.APIClient, method string) error {
+	managed := d.Get("extra_attributes").(*schema.Set).List()
+	if len(managed) == 0 {
+		return nil
+	}
+
+	entry, err := client.EntryShow(method, d.Id(), JSON{
+		"all": true, // Retrieves all attributes, not only the default ones
+		"raw": true, // Values as stored in LDAP
+	})
+	if err != nil {
+		return err
+	}
+
+	// Attribute names are case insensitive
+	attributes := make(map[string]interface{})
+	for name, value := range *entry {
+		attributes[strings.ToLower(name)] = value
+	}
+
+	flat := make([]JSON, 0, len(managed))
+	for _, attribute := range managed {
+		name := attribute.(map[string]interface{})["name"].(string)
+		flat = append(flat, JSON{
+			"name":   name,
+			"values": entryAttributeValues(attributes[strings.ToLower(name)]),
+		})
+	}
+
+	return d.Set("extra_attributes", flat)
+}
@@ -1,6 +1,7 @@
 package freeipa
 
 import (
+	"crypto/x509"
 	"fmt"
 	"unicode"
 )
@@ -34,3 +35,21 @@ func StringContainsNoUpperLetter(i interface{}, k string) ([]string, []error) {
 
 	return nil, nil
 }
+
+func StringIsCertificate(i interface{}, k string) ([]string, []error) {
+	v, ok := i.(string)
+	if !ok {
+		return nil, []error{fmt.Errorf("expected type of %q to be string", k)}
+	}
+
+	der, err := decodeCertificate(v)
+	if err != nil {
+		return nil, []error{fmt.Errorf("expected %q to be a PEM or base64 encoded certificate: %s", k, err)}
+	}
+
+	if _, err := x509.ParseCertificate(der); err != nil {
+		return nil, []error{fmt.Errorf("expected %q to be a valid certificate: %s", k, err)}
+	}
+
+	return nil, nil
+}